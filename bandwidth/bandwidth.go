@@ -0,0 +1,229 @@
+package bandwidth
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// defaultInterval is used when bandwidth_test_interval is not a positive number of seconds.
+const defaultInterval = 10 * time.Minute
+
+// requestTimeout bounds each test request, so a hung endpoint does not stall a run for the whole interval.
+const requestTimeout = time.Minute
+
+// Tester periodically measures the exporter host throughput and latency against an HTTP endpoint.
+type Tester struct {
+	url      string
+	size     int
+	interval time.Duration
+	client   *http.Client
+
+	download *prometheus.GaugeVec
+	upload   *prometheus.GaugeVec
+	latency  *prometheus.GaugeVec
+	success  *prometheus.GaugeVec
+	lastRun  *prometheus.GaugeVec
+}
+
+// NewTester creates a Tester from the [MKTXP] bandwidth settings.
+func NewTester(cfg *config.MKTXPConfig) *Tester {
+	interval := time.Duration(cfg.BandwidthTestInterval) * time.Second
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	return &Tester{
+		url:      cfg.BandwidthTestURL,
+		size:     cfg.BandwidthTestSize,
+		interval: interval,
+		client:   &http.Client{Timeout: min(interval, requestTimeout)},
+	}
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (t *Tester) Declare(registry prometheus.Registerer) error {
+	t.download = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "internet_download_speed",
+			Help:      "Download speed of the exporter host (in bits per second).",
+		},
+		[]string{"url"},
+	)
+	t.upload = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "internet_upload_speed",
+			Help:      "Upload speed of the exporter host (in bits per second).",
+		},
+		[]string{"url"},
+	)
+	t.latency = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "internet_latency",
+			Help:      "Latency of the exporter host to the bandwidth test endpoint (in seconds).",
+		},
+		[]string{"url"},
+	)
+
+	t.success = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "internet_test_success",
+			Help:      "Whether the last bandwidth test step succeeded (1) or not (0).",
+		},
+		[]string{"url", "test"},
+	)
+	t.lastRun = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "internet_test_last_run_timestamp",
+			Help:      "Unix timestamp of the last bandwidth test run.",
+		},
+		[]string{"url"},
+	)
+
+	// Register all metrics
+	for _, metric := range []*prometheus.GaugeVec{t.download, t.upload, t.latency, t.success, t.lastRun} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Run measures the bandwidth every interval until the context is canceled.
+func (t *Tester) Run(ctx context.Context) {
+	ticker := time.NewTicker(t.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := t.Measure(ctx); err != nil {
+			log.Printf("failed to measure bandwidth: %v", err)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			log.Println("Stopping bandwidth tester")
+			return
+		}
+	}
+}
+
+// Measure runs a latency, download and upload test and sets Prometheus metrics. The steps run
+// independently, the result of a failed step is removed so a broken endpoint does not keep the last value.
+func (t *Tester) Measure(ctx context.Context) error {
+	defer t.lastRun.WithLabelValues(t.url).SetToCurrentTime()
+
+	var errs []error
+	for _, step := range []struct {
+		name    string
+		metric  *prometheus.GaugeVec
+		measure func(context.Context) (float64, error)
+	}{
+		{"latency", t.latency, func(ctx context.Context) (float64, error) {
+			latency, err := t.measureLatency(ctx)
+			return latency.Seconds(), err
+		}},
+		{"download", t.download, t.measureDownload},
+		{"upload", t.upload, t.measureUpload},
+	} {
+		value, err := step.measure(ctx)
+		if err != nil {
+			step.metric.DeleteLabelValues(t.url)
+			t.success.WithLabelValues(t.url, step.name).Set(0)
+			errs = append(errs, fmt.Errorf("%s test failed: %w", step.name, err))
+			continue
+		}
+		step.metric.WithLabelValues(t.url).Set(value)
+		t.success.WithLabelValues(t.url, step.name).Set(1)
+	}
+
+	return errors.Join(errs...)
+}
+
+// measureLatency returns the round trip time of a HEAD request.
+func (t *Tester) measureLatency(ctx context.Context) (time.Duration, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, t.url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	elapsed := time.Since(start)
+	_ = resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return 0, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return elapsed, nil
+}
+
+// measureDownload returns the download speed in bits per second, reading at most size bytes.
+func (t *Tester) measureDownload(ctx context.Context) (float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, t.url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	read, err := io.Copy(io.Discard, io.LimitReader(resp.Body, int64(t.size)))
+	if err != nil {
+		return 0, err
+	}
+
+	return bitsPerSecond(read, time.Since(start)), nil
+}
+
+// measureUpload returns the upload speed in bits per second, posting size bytes.
+func (t *Tester) measureUpload(ctx context.Context) (float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.url, bytes.NewReader(make([]byte, t.size)))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	start := time.Now()
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	elapsed := time.Since(start)
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return 0, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return bitsPerSecond(int64(t.size), elapsed), nil
+}
+
+func bitsPerSecond(transferred int64, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(transferred) * 8 / elapsed.Seconds()
+}
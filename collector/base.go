@@ -4,6 +4,9 @@ import (
 	"context"
 	"crypto/tls"
 	"fmt"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/bumbacea/go-mktxp/config"
@@ -28,6 +31,9 @@ type RouterEntry struct {
 	ConfigEntry config.RouterConfig
 	Conn        *routeros.Client
 	Collectors  []Collector
+
+	// routerOSVersion caches the version reported by /system/resource, e.g. "7.14.3 (stable)".
+	routerOSVersion string
 }
 
 func NewRouterEntry(cfg config.RouterConfig) (*RouterEntry, error) {
@@ -68,6 +74,43 @@ func (e *RouterEntry) Collect(ctx context.Context) error {
 	return nil
 }
 
+// SetRouterOSVersion records the RouterOS version reported by the router.
+func (e *RouterEntry) SetRouterOSVersion(version string) {
+	e.routerOSVersion = version
+}
+
+// RouterOSVersion returns the RouterOS version of the router, querying it when it was not yet reported.
+func (e *RouterEntry) RouterOSVersion(ctx context.Context) (string, error) {
+	if e.routerOSVersion != "" {
+		return e.routerOSVersion, nil
+	}
+	reply, err := e.Conn.RunContext(ctx, "/system/resource/print", "proplist=version")
+	if err != nil {
+		return "", fmt.Errorf("failed to run /system/resource/print command: %w", err)
+	}
+	for _, sentence := range reply.Re {
+		e.routerOSVersion = sentence.Map["version"]
+	}
+	if e.routerOSVersion == "" {
+		return "", fmt.Errorf("missing 'version' field in /system/resource/print response")
+	}
+	return e.routerOSVersion, nil
+}
+
+// IsRouterOSv7 reports whether the router runs RouterOS 7 or newer.
+func (e *RouterEntry) IsRouterOSv7(ctx context.Context) (bool, error) {
+	version, err := e.RouterOSVersion(ctx)
+	if err != nil {
+		return false, err
+	}
+	major, _, _ := strings.Cut(version, ".")
+	majorVersion, err := strconv.Atoi(major)
+	if err != nil {
+		return false, fmt.Errorf("invalid RouterOS version %q: %w", version, err)
+	}
+	return majorVersion >= 7, nil
+}
+
 func DeclareAll(registry *prometheus.Registry) error {
 	for _, connector := range availableConnectors {
 		err := connector.Declare(registry)
@@ -77,3 +120,82 @@ func DeclareAll(registry *prometheus.Registry) error {
 	}
 	return nil
 }
+
+// GaugeVec is a prometheus.GaugeVec that remembers the label sets written for each router, so the
+// series of entries that disappeared from the device can be dropped without removing the others.
+type GaugeVec struct {
+	*prometheus.GaugeVec
+	labelNames []string
+
+	mu sync.Mutex
+	// written holds the label sets set since the last DeleteStaleMetrics, exported the ones kept by it, per router.
+	written  map[string]map[string]prometheus.Labels
+	exported map[string]map[string]prometheus.Labels
+}
+
+// NewGaugeVec creates a GaugeVec, the labels must include routerboard_name.
+func NewGaugeVec(opts prometheus.GaugeOpts, labelNames []string) *GaugeVec {
+	return &GaugeVec{
+		GaugeVec:   prometheus.NewGaugeVec(opts, labelNames),
+		labelNames: labelNames,
+		written:    make(map[string]map[string]prometheus.Labels),
+		exported:   make(map[string]map[string]prometheus.Labels),
+	}
+}
+
+// With returns the gauge for the labels and records them as written.
+func (g *GaugeVec) With(labels prometheus.Labels) prometheus.Gauge {
+	gauge := g.GaugeVec.With(labels)
+	g.track(labels)
+	return gauge
+}
+
+// WithLabelValues returns the gauge for the label values and records them as written.
+func (g *GaugeVec) WithLabelValues(values ...string) prometheus.Gauge {
+	gauge := g.GaugeVec.WithLabelValues(values...)
+	labels := make(prometheus.Labels, len(values))
+	for i, name := range g.labelNames {
+		labels[name] = values[i]
+	}
+	g.track(labels)
+	return gauge
+}
+
+func (g *GaugeVec) track(labels prometheus.Labels) {
+	values := make([]string, len(g.labelNames))
+	for i, name := range g.labelNames {
+		values[i] = labels[name]
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	router := labels["routerboard_name"]
+	if g.written[router] == nil {
+		g.written[router] = make(map[string]prometheus.Labels)
+	}
+	g.written[router][strings.Join(values, "\xff")] = labels
+}
+
+// deleteStale drops the series of the router that were not written since the previous call.
+func (g *GaugeVec) deleteStale(router string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	written := g.written[router]
+	for key, labels := range g.exported[router] {
+		if _, ok := written[key]; !ok {
+			g.GaugeVec.Delete(labels)
+		}
+	}
+	g.exported[router] = written
+	delete(g.written, router)
+}
+
+// DeleteStaleMetrics drops the series of the router that were not written during this collection, so
+// entries that disappeared from the device (closed sessions, removed peers) are not reported forever
+// with their last value. Collectors defer it before setting values, the series still present on the
+// device are never removed and stay visible to scrapes running meanwhile.
+func DeleteStaleMetrics(router *RouterEntry, metrics ...*GaugeVec) {
+	for _, metric := range metrics {
+		metric.deleteStale(router.ConfigEntry.Name)
+	}
+}
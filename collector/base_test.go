@@ -0,0 +1,47 @@
+package collector
+
+import (
+	"testing"
+
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func countSeries(vec *GaugeVec) int {
+	ch := make(chan prometheus.Metric, 16)
+	vec.Collect(ch)
+	close(ch)
+	return len(ch)
+}
+
+func TestDeleteStaleMetrics(t *testing.T) {
+	vec := NewGaugeVec(prometheus.GaugeOpts{Name: "test"}, []string{"peer", "routerboard_address", "routerboard_name"})
+	first := &RouterEntry{ConfigEntry: config.RouterConfig{Name: "first", Hostname: "10.0.0.1"}}
+	second := &RouterEntry{ConfigEntry: config.RouterConfig{Name: "second", Hostname: "10.0.0.2"}}
+
+	vec.WithLabelValues("a", "10.0.0.1", "first").Set(1)
+	vec.With(prometheus.Labels{"peer": "b", "routerboard_address": "10.0.0.1", "routerboard_name": "first"}).Set(1)
+	vec.WithLabelValues("a", "10.0.0.2", "second").Set(1)
+	DeleteStaleMetrics(first, vec)
+	DeleteStaleMetrics(second, vec)
+	if got := countSeries(vec); got != 3 {
+		t.Fatalf("series after first collection = %d, want 3", got)
+	}
+
+	// Peer "b" disappeared from the first router, the second router was not collected again yet
+	vec.WithLabelValues("a", "10.0.0.1", "first").Set(2)
+	DeleteStaleMetrics(first, vec)
+	if got := countSeries(vec); got != 2 {
+		t.Fatalf("series after second collection = %d, want 2", got)
+	}
+
+	DeleteStaleMetrics(second, vec)
+	if got := countSeries(vec); got != 1 {
+		t.Fatalf("series after empty collection of the second router = %d, want 1", got)
+	}
+
+	DeleteStaleMetrics(first, vec)
+	if got := countSeries(vec); got != 0 {
+		t.Fatalf("series after empty collection = %d, want 0", got)
+	}
+}
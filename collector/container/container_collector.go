@@ -0,0 +1,150 @@
+package container
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/go-routeros/routeros/v3"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&ContainerCollector{})
+}
+
+type ContainerCollector struct {
+	info       *collector.GaugeVec
+	running    *collector.GaugeVec
+	memoryHigh *collector.GaugeVec
+	ramHigh    *collector.GaugeVec
+}
+
+// Collect retrieves containers and the container memory limits and sets Prometheus metrics.
+func (c *ContainerCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	v7, err := router.IsRouterOSv7(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to detect RouterOS version: %w", err)
+	}
+	// Containers are only available on RouterOS v7
+	if !v7 {
+		return nil
+	}
+
+	rply, err := router.Conn.RunContext(ctx, "/container/print", "proplist=name,tag,root-dir,interface,status,memory-high,comment")
+	// The menu is missing when the container package is not installed, report no containers
+	var deviceErr *routeros.DeviceError
+	if errors.As(err, &deviceErr) {
+		collector.DeleteStaleMetrics(router, c.info, c.running, c.memoryHigh, c.ramHigh)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to run /container/print command: %w", err)
+	}
+
+	configReply, err := router.Conn.RunContext(ctx, "/container/config/print", "proplist=ram-high")
+	if err != nil {
+		return fmt.Errorf("failed to run /container/config/print command: %w", err)
+	}
+
+	defer collector.DeleteStaleMetrics(router, c.info, c.running, c.memoryHigh, c.ramHigh)
+
+	for _, sentence := range rply.Re {
+		// Containers created before RouterOS 7.12 have no name, fall back to the image tag
+		name := sentence.Map["name"]
+		if name == "" {
+			name = sentence.Map["tag"]
+		}
+		labels := prometheus.Labels{
+			"name":                name,
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		running := 0.0
+		if sentence.Map["status"] == "running" {
+			running = 1
+		}
+		c.running.With(labels).Set(running)
+
+		// A zero or missing limit means the container memory is not limited
+		if memoryHigh, err := parse.Size(sentence.Map["memory-high"]); err == nil {
+			c.memoryHigh.With(labels).Set(memoryHigh)
+		}
+
+		c.info.WithLabelValues(
+			name,
+			sentence.Map["tag"],
+			sentence.Map["root-dir"],
+			sentence.Map["interface"],
+			sentence.Map["status"],
+			sentence.Map["comment"],
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+	}
+
+	for _, sentence := range configReply.Re {
+		if ramHigh, err := parse.Size(sentence.Map["ram-high"]); err == nil {
+			c.ramHigh.WithLabelValues(router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(ramHigh)
+		}
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (c *ContainerCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.Container == nil {
+		return false
+	}
+	return *entry.Container
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (c *ContainerCollector) Declare(registry prometheus.Registerer) error {
+	c.info = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "container_info",
+			Help:      "Information about containers.",
+		},
+		[]string{"name", "tag", "root_dir", "interface", "status", "comment", "routerboard_address", "routerboard_name"},
+	)
+	c.running = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "container_running",
+			Help:      "Whether the container is running (1) or not (0).",
+		},
+		[]string{"name", "routerboard_address", "routerboard_name"},
+	)
+	c.memoryHigh = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "container_memory_high",
+			Help:      "Memory limit of the container (in bytes, 0 is unlimited).",
+		},
+		[]string{"name", "routerboard_address", "routerboard_name"},
+	)
+	c.ramHigh = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "container_config_ram_high",
+			Help:      "Memory limit shared by all containers (in bytes, 0 is unlimited).",
+		},
+		[]string{"routerboard_address", "routerboard_name"},
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{c.info, c.running, c.memoryHigh, c.ramHigh} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&BridgeCollector{})
+}
+
+type BridgeCollector struct {
+	hosts          *collector.GaugeVec
+	portInfo       *collector.GaugeVec
+	portForwarding *collector.GaugeVec
+	vlanInfo       *collector.GaugeVec
+}
+
+// Collect retrieves bridge hosts, ports and VLANs and sets Prometheus metrics.
+func (b *BridgeCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	hostReply, err := router.Conn.RunContext(ctx, "/interface/bridge/host/print", "?local=false", "proplist=bridge,vid,on-interface")
+	if err != nil {
+		return fmt.Errorf("failed to run /interface/bridge/host/print command: %w", err)
+	}
+
+	portReply, err := router.Conn.RunContext(ctx, "/interface/bridge/port/print", "proplist=bridge,interface,role,status,forwarding,learning,edge-port,pvid,disabled")
+	if err != nil {
+		return fmt.Errorf("failed to run /interface/bridge/port/print command: %w", err)
+	}
+
+	vlanReply, err := router.Conn.RunContext(ctx, "/interface/bridge/vlan/print", "proplist=bridge,vlan-ids,current-tagged,current-untagged,dynamic,disabled")
+	if err != nil {
+		return fmt.Errorf("failed to run /interface/bridge/vlan/print command: %w", err)
+	}
+
+	defer collector.DeleteStaleMetrics(router, b.hosts, b.portInfo, b.portForwarding, b.vlanInfo)
+
+	// Count learned hosts per bridge, VLAN and port, the bridge's own addresses are excluded
+	hostCount := make(map[[3]string]float64)
+	for _, sentence := range hostReply.Re {
+		hostCount[[3]string{sentence.Map["bridge"], sentence.Map["vid"], sentence.Map["on-interface"]}]++
+	}
+	for key, count := range hostCount {
+		b.hosts.WithLabelValues(key[0], key[1], key[2], router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(count)
+	}
+
+	for _, sentence := range portReply.Re {
+		if sentence.Map["disabled"] == "true" {
+			continue
+		}
+
+		// The STP state is only exposed through the forwarding / learning flags
+		state := "discarding"
+		switch {
+		case sentence.Map["forwarding"] == "true":
+			state = "forwarding"
+		case sentence.Map["learning"] == "true":
+			state = "learning"
+		}
+
+		b.portInfo.WithLabelValues(
+			sentence.Map["bridge"],
+			sentence.Map["interface"],
+			sentence.Map["role"],
+			state,
+			sentence.Map["status"],
+			sentence.Map["edge-port"],
+			sentence.Map["pvid"],
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+
+		if forwarding, err := parse.Bool(sentence.Map["forwarding"]); err == nil {
+			b.portForwarding.WithLabelValues(sentence.Map["bridge"], sentence.Map["interface"], router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(forwarding)
+		}
+	}
+
+	for _, sentence := range vlanReply.Re {
+		if sentence.Map["disabled"] == "true" {
+			continue
+		}
+
+		b.vlanInfo.WithLabelValues(
+			sentence.Map["bridge"],
+			sentence.Map["vlan-ids"],
+			sentence.Map["current-tagged"],
+			sentence.Map["current-untagged"],
+			sentence.Map["dynamic"],
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (b *BridgeCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.Bridge == nil {
+		return false
+	}
+	return *entry.Bridge
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (b *BridgeCollector) Declare(registry prometheus.Registerer) error {
+	b.hosts = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "bridge_hosts",
+			Help:      "Number of hosts learned by the bridge per VLAN and port.",
+		},
+		[]string{"bridge", "vid", "port", "routerboard_address", "routerboard_name"},
+	)
+	b.portInfo = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "bridge_port_info",
+			Help:      "Information about bridge ports and their STP role and state.",
+		},
+		[]string{"bridge", "interface", "role", "state", "status", "edge_port", "pvid", "routerboard_address", "routerboard_name"},
+	)
+	b.portForwarding = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "bridge_port_forwarding",
+			Help:      "Whether the bridge port is forwarding (1) or not (0).",
+		},
+		[]string{"bridge", "interface", "routerboard_address", "routerboard_name"},
+	)
+	b.vlanInfo = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "bridge_vlan_info",
+			Help:      "Information about the bridge VLAN table.",
+		},
+		[]string{"bridge", "vlan_ids", "tagged", "untagged", "dynamic", "routerboard_address", "routerboard_name"},
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{b.hosts, b.portInfo, b.portForwarding, b.vlanInfo} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&SwitchPortCollector{})
+}
+
+// switchPortStats maps the switch port stats fields to the exported metric names.
+var switchPortStats = []struct {
+	key  string
+	name string
+	help string
+}{
+	{"rx-bytes", "switch_rx_bytes", "Number of bytes received by the switch port."},
+	{"tx-bytes", "switch_tx_bytes", "Number of bytes sent by the switch port."},
+	{"rx-broadcast", "switch_rx_broadcast", "Number of broadcast frames received by the switch port."},
+	{"tx-broadcast", "switch_tx_broadcast", "Number of broadcast frames sent by the switch port."},
+	{"rx-multicast", "switch_rx_multicast", "Number of multicast frames received by the switch port."},
+	{"tx-multicast", "switch_tx_multicast", "Number of multicast frames sent by the switch port."},
+	{"rx-fcs-error", "switch_rx_fcs_error", "Number of frames with FCS errors received by the switch port."},
+	{"rx-pause", "switch_rx_pause", "Number of pause frames received by the switch port."},
+	{"tx-pause", "switch_tx_pause", "Number of pause frames sent by the switch port."},
+	{"rx-too-short", "switch_rx_too_short", "Number of too short frames received by the switch port."},
+	{"rx-too-long", "switch_rx_too_long", "Number of too long frames received by the switch port."},
+	{"tx-too-long", "switch_tx_too_long", "Number of too long frames sent by the switch port."},
+}
+
+type SwitchPortCollector struct {
+	stats []*collector.GaugeVec
+}
+
+// Collect retrieves switch chip port counters and sets Prometheus metrics.
+func (s *SwitchPortCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	rply, err := router.Conn.RunContext(ctx, "/interface/ethernet/switch/port/print", "=stats=")
+	if err != nil {
+		return fmt.Errorf("failed to run /interface/ethernet/switch/port/print command: %w", err)
+	}
+
+	defer collector.DeleteStaleMetrics(router, s.stats...)
+
+	for _, sentence := range rply.Re {
+		labels := prometheus.Labels{
+			"name":                sentence.Map["name"],
+			"switch":              sentence.Map["switch"],
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		// Not every switch chip reports every counter, skip the missing ones
+		for idx, stat := range switchPortStats {
+			value, err := strconv.ParseFloat(sentence.Map[stat.key], 64)
+			if err == nil {
+				s.stats[idx].With(labels).Set(value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (s *SwitchPortCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.SwitchPort == nil {
+		return false
+	}
+	return *entry.SwitchPort
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (s *SwitchPortCollector) Declare(registry prometheus.Registerer) error {
+	commonLabels := []string{"name", "switch", "routerboard_address", "routerboard_name"}
+
+	s.stats = make([]*collector.GaugeVec, 0, len(switchPortStats))
+	for _, stat := range switchPortStats {
+		metric := collector.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "mktxp",
+				Name:      stat.name,
+				Help:      stat.help,
+			},
+			commonLabels,
+		)
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+		s.stats = append(s.stats, metric)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&VRRPCollector{})
+}
+
+type VRRPCollector struct {
+	info     *collector.GaugeVec
+	master   *collector.GaugeVec
+	priority *collector.GaugeVec
+}
+
+// Collect retrieves VRRP instances and sets Prometheus metrics.
+func (v *VRRPCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	rply, err := router.Conn.RunContext(ctx, "/interface/vrrp/print", "proplist=name,interface,vrid,priority,master,backup,running,disabled")
+	if err != nil {
+		return fmt.Errorf("failed to run /interface/vrrp/print command: %w", err)
+	}
+
+	defer collector.DeleteStaleMetrics(router, v.info, v.master, v.priority)
+
+	for _, sentence := range rply.Re {
+		labels := prometheus.Labels{
+			"name":                sentence.Map["name"],
+			"interface":           sentence.Map["interface"],
+			"vrid":                sentence.Map["vrid"],
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		// The state is only exposed through the master / backup flags
+		state := "init"
+		master := 0.0
+		switch {
+		case sentence.Map["disabled"] == "true":
+			state = "disabled"
+		case sentence.Map["master"] == "true":
+			state = "master"
+			master = 1
+		case sentence.Map["backup"] == "true":
+			state = "backup"
+		}
+		v.master.With(labels).Set(master)
+
+		if priority, err := strconv.ParseFloat(sentence.Map["priority"], 64); err == nil {
+			v.priority.With(labels).Set(priority)
+		}
+
+		v.info.WithLabelValues(
+			sentence.Map["name"],
+			sentence.Map["interface"],
+			sentence.Map["vrid"],
+			state,
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (v *VRRPCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.VRRP == nil {
+		return false
+	}
+	return *entry.VRRP
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (v *VRRPCollector) Declare(registry prometheus.Registerer) error {
+	commonLabels := []string{"name", "interface", "vrid", "routerboard_address", "routerboard_name"}
+
+	v.info = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "vrrp_info",
+			Help:      "Information about VRRP instances.",
+		},
+		[]string{"name", "interface", "vrid", "state", "routerboard_address", "routerboard_name"},
+	)
+	v.master = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "vrrp_master",
+			Help:      "Whether the VRRP instance is master (1) or not (0).",
+		},
+		commonLabels,
+	)
+	v.priority = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "vrrp_priority",
+			Help:      "VRRP instance priority.",
+		},
+		commonLabels,
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{v.info, v.master, v.priority} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
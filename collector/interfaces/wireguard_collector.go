@@ -0,0 +1,155 @@
+package interfaces
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// publicKeyPrefixLength is the number of public key characters used to name peers without a comment.
+const publicKeyPrefixLength = 8
+
+func init() {
+	collector.RegisterAvailableCollector(&WireGuardCollector{})
+}
+
+type WireGuardCollector struct {
+	peerInfo      *collector.GaugeVec
+	lastHandshake *collector.GaugeVec
+	rxBytes       *collector.GaugeVec
+	txBytes       *collector.GaugeVec
+}
+
+// Collect retrieves WireGuard peers and sets Prometheus metrics.
+func (w *WireGuardCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	v7, err := router.IsRouterOSv7(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to detect RouterOS version: %w", err)
+	}
+	// WireGuard is only available on RouterOS v7
+	if !v7 {
+		return nil
+	}
+
+	rply, err := router.Conn.RunContext(ctx, "/interface/wireguard/peers/print", "proplist=interface,public-key,comment,endpoint-address,endpoint-port,current-endpoint-address,current-endpoint-port,allowed-address,rx,tx,last-handshake,disabled")
+	if err != nil {
+		return fmt.Errorf("failed to run /interface/wireguard/peers/print command: %w", err)
+	}
+
+	now := time.Now()
+	defer collector.DeleteStaleMetrics(router, w.peerInfo, w.lastHandshake, w.rxBytes, w.txBytes)
+
+	for _, sentence := range rply.Re {
+		if sentence.Map["disabled"] == "true" {
+			continue
+		}
+
+		peer := sentence.Map["comment"]
+		if peer == "" {
+			peer = sentence.Map["public-key"]
+			if len(peer) > publicKeyPrefixLength {
+				peer = peer[:publicKeyPrefixLength]
+			}
+		}
+
+		labels := prometheus.Labels{
+			"interface":           sentence.Map["interface"],
+			"peer":                peer,
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		if rx, err := strconv.ParseFloat(sentence.Map["rx"], 64); err == nil {
+			w.rxBytes.With(labels).Set(rx)
+		}
+		if tx, err := strconv.ParseFloat(sentence.Map["tx"], 64); err == nil {
+			w.txBytes.With(labels).Set(tx)
+		}
+
+		// last-handshake is the time elapsed since the handshake, it is missing for peers that never connected
+		if elapsed, err := parse.Duration(sentence.Map["last-handshake"]); err == nil {
+			w.lastHandshake.With(labels).Set(float64(now.Unix()) - elapsed)
+		}
+
+		// Prefer the endpoint the peer is currently connecting from over the configured one
+		endpoint := sentence.Map["current-endpoint-address"]
+		port := sentence.Map["current-endpoint-port"]
+		if endpoint == "" {
+			endpoint = sentence.Map["endpoint-address"]
+			port = sentence.Map["endpoint-port"]
+		}
+		w.peerInfo.WithLabelValues(
+			sentence.Map["interface"],
+			peer,
+			sentence.Map["public-key"],
+			endpoint,
+			port,
+			sentence.Map["allowed-address"],
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (w *WireGuardCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.WireGuard == nil {
+		return false
+	}
+	return *entry.WireGuard
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (w *WireGuardCollector) Declare(registry prometheus.Registerer) error {
+	commonLabels := []string{"interface", "peer", "routerboard_address", "routerboard_name"}
+
+	w.peerInfo = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "wireguard_peer_info",
+			Help:      "Information about WireGuard peers.",
+		},
+		[]string{"interface", "peer", "public_key", "endpoint_address", "endpoint_port", "allowed_address", "routerboard_address", "routerboard_name"},
+	)
+	w.lastHandshake = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "wireguard_peer_last_handshake",
+			Help:      "Time of the last WireGuard peer handshake as a Unix timestamp.",
+		},
+		commonLabels,
+	)
+	w.rxBytes = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "wireguard_peer_rx_bytes",
+			Help:      "Number of bytes received from the WireGuard peer.",
+		},
+		commonLabels,
+	)
+	w.txBytes = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "wireguard_peer_tx_bytes",
+			Help:      "Number of bytes sent to the WireGuard peer.",
+		},
+		commonLabels,
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{w.peerInfo, w.lastHandshake, w.rxBytes, w.txBytes} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
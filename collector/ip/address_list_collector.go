@@ -0,0 +1,91 @@
+package ip
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&AddressListCollector{})
+}
+
+type AddressListCollector struct {
+	entries *collector.GaugeVec
+}
+
+// Collect counts the entries of the configured firewall address lists and sets Prometheus metrics.
+func (a *AddressListCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	for _, family := range []struct {
+		name  string
+		menu  string
+		lists string
+	}{
+		{"ipv4", "/ip/firewall/address-list", router.ConfigEntry.AddressList},
+		{"ipv6", "/ipv6/firewall/address-list", router.ConfigEntry.IPv6AddressList},
+	} {
+		for _, list := range parseListNames(family.lists) {
+			// Count-only queries keep lists with a large number of entries cheap to collect
+			for _, dynamic := range []string{"true", "false"} {
+				rply, err := router.Conn.RunContext(ctx, family.menu+"/print", "=count-only=", "?list="+list, "?dynamic="+dynamic)
+				if err != nil {
+					return fmt.Errorf("failed to run %s/print command: %w", family.menu, err)
+				}
+				if rply.Done == nil {
+					continue
+				}
+
+				count, err := strconv.ParseFloat(rply.Done.Map["ret"], 64)
+				if err == nil {
+					a.entries.WithLabelValues(list, family.name, dynamic, router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(count)
+				}
+			}
+		}
+	}
+
+	// Only after every query succeeded, a failure keeps the last values of the lists
+	collector.DeleteStaleMetrics(router, a.entries)
+
+	return nil
+}
+
+// parseListNames splits a comma-separated address_list setting, "None" disables it.
+func parseListNames(lists string) []string {
+	names := make([]string, 0)
+	for _, name := range strings.Split(lists, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" || name == "None" {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (a *AddressListCollector) IsEnabled(entry config.RouterConfig) bool {
+	return len(parseListNames(entry.AddressList)) > 0 || len(parseListNames(entry.IPv6AddressList)) > 0
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (a *AddressListCollector) Declare(registry prometheus.Registerer) error {
+	a.entries = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "firewall_address_list_entries",
+			Help:      "Number of entries in the firewall address list.",
+		},
+		[]string{"list", "family", "dynamic", "routerboard_address", "routerboard_name"},
+	)
+
+	if err := registry.Register(a.entries); err != nil {
+		return fmt.Errorf("failed to register metric: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package ip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/go-routeros/routeros/v3"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&DHCPClientCollector{})
+}
+
+type DHCPClientCollector struct {
+	info          *collector.GaugeVec
+	bound         *collector.GaugeVec
+	leaseExpires  *collector.GaugeVec
+	ipv6Info      *collector.GaugeVec
+	ipv6Bound     *collector.GaugeVec
+	prefixExpires *collector.GaugeVec
+}
+
+// Collect retrieves the DHCP and DHCPv6 clients and sets Prometheus metrics.
+func (d *DHCPClientCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	rply, err := router.Conn.RunContext(ctx, "/ip/dhcp-client/print", "proplist=interface,status,address,gateway,dhcp-server,expires-after,disabled")
+	if err != nil {
+		return fmt.Errorf("failed to run /ip/dhcp-client/print command: %w", err)
+	}
+
+	// The IPv6 menu is missing when the ipv6 package is disabled on RouterOS v6
+	ipv6Reply, err := router.Conn.RunContext(ctx, "/ipv6/dhcp-client/print", "proplist=interface,status,prefix,pool-name,expires-after,disabled")
+	var deviceErr *routeros.DeviceError
+	if err != nil && !errors.As(err, &deviceErr) {
+		return fmt.Errorf("failed to run /ipv6/dhcp-client/print command: %w", err)
+	}
+
+	defer collector.DeleteStaleMetrics(router, d.info, d.bound, d.leaseExpires, d.ipv6Info, d.ipv6Bound, d.prefixExpires)
+
+	for _, sentence := range rply.Re {
+		if sentence.Map["disabled"] == "true" {
+			continue
+		}
+
+		labels := prometheus.Labels{
+			"interface":           sentence.Map["interface"],
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		bound := 0.0
+		if sentence.Map["status"] == "bound" {
+			bound = 1
+		}
+		d.bound.With(labels).Set(bound)
+
+		if expires, err := parse.Duration(sentence.Map["expires-after"]); err == nil {
+			d.leaseExpires.With(labels).Set(expires)
+		}
+
+		d.info.WithLabelValues(
+			sentence.Map["interface"],
+			sentence.Map["status"],
+			sentence.Map["address"],
+			sentence.Map["gateway"],
+			sentence.Map["dhcp-server"],
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+	}
+
+	if ipv6Reply == nil {
+		return nil
+	}
+
+	for _, sentence := range ipv6Reply.Re {
+		if sentence.Map["disabled"] == "true" {
+			continue
+		}
+
+		// RouterOS v6 appends the prefix lifetime to the prefix, e.g. "2001:db8::/56, 23h59m"
+		prefix, expiresAfter, _ := strings.Cut(sentence.Map["prefix"], ",")
+		prefix = strings.TrimSpace(prefix)
+		if expiresAfter == "" {
+			expiresAfter = sentence.Map["expires-after"]
+		}
+
+		labels := prometheus.Labels{
+			"interface":           sentence.Map["interface"],
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		bound := 0.0
+		if sentence.Map["status"] == "bound" {
+			bound = 1
+		}
+		d.ipv6Bound.With(labels).Set(bound)
+
+		if expires, err := parse.Duration(strings.TrimSpace(expiresAfter)); err == nil {
+			d.prefixExpires.With(labels).Set(expires)
+		}
+
+		d.ipv6Info.WithLabelValues(
+			sentence.Map["interface"],
+			sentence.Map["status"],
+			prefix,
+			sentence.Map["pool-name"],
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (d *DHCPClientCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.DHCPClient == nil {
+		return false
+	}
+	return *entry.DHCPClient
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (d *DHCPClientCollector) Declare(registry prometheus.Registerer) error {
+	commonLabels := []string{"interface", "routerboard_address", "routerboard_name"}
+
+	d.info = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "dhcp_client_info",
+			Help:      "Information about DHCP clients and their obtained lease.",
+		},
+		[]string{"interface", "status", "address", "gateway", "dhcp_server", "routerboard_address", "routerboard_name"},
+	)
+	d.bound = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "dhcp_client_bound",
+			Help:      "Whether the DHCP client is bound to a lease (1) or not (0).",
+		},
+		commonLabels,
+	)
+	d.leaseExpires = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "dhcp_client_lease_expires",
+			Help:      "Time left before the DHCP client lease expires (in seconds).",
+		},
+		commonLabels,
+	)
+	d.ipv6Info = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "dhcpv6_client_info",
+			Help:      "Information about DHCPv6 clients and their delegated prefix.",
+		},
+		[]string{"interface", "status", "prefix", "pool_name", "routerboard_address", "routerboard_name"},
+	)
+	d.ipv6Bound = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "dhcpv6_client_bound",
+			Help:      "Whether the DHCPv6 client is bound (1) or not (0).",
+		},
+		commonLabels,
+	)
+	d.prefixExpires = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "dhcpv6_client_prefix_expires",
+			Help:      "Time left before the DHCPv6 delegated prefix expires (in seconds).",
+		},
+		commonLabels,
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{d.info, d.bound, d.leaseExpires, d.ipv6Info, d.ipv6Bound, d.prefixExpires} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
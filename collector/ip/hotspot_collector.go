@@ -0,0 +1,169 @@
+package ip
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&HotspotCollector{})
+}
+
+type HotspotCollector struct {
+	activeInfo      *collector.GaugeVec
+	uptime          *collector.GaugeVec
+	sessionTimeLeft *collector.GaugeVec
+	idleTime        *collector.GaugeVec
+	bytesIn         *collector.GaugeVec
+	bytesOut        *collector.GaugeVec
+	hosts           *collector.GaugeVec
+}
+
+// Collect retrieves hotspot active users and hosts and sets Prometheus metrics.
+func (h *HotspotCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	activeReply, err := router.Conn.RunContext(ctx, "/ip/hotspot/active/print", "proplist=server,user,address,mac-address,login-by,uptime,session-time-left,idle-time,bytes-in,bytes-out")
+	if err != nil {
+		return fmt.Errorf("failed to run /ip/hotspot/active/print command: %w", err)
+	}
+
+	hostReply, err := router.Conn.RunContext(ctx, "/ip/hotspot/host/print", "proplist=server,authorized,bypassed")
+	if err != nil {
+		return fmt.Errorf("failed to run /ip/hotspot/host/print command: %w", err)
+	}
+
+	defer collector.DeleteStaleMetrics(router, h.activeInfo, h.uptime, h.sessionTimeLeft, h.idleTime, h.bytesIn, h.bytesOut, h.hosts)
+
+	for _, sentence := range activeReply.Re {
+		labels := prometheus.Labels{
+			"server":              sentence.Map["server"],
+			"user":                sentence.Map["user"],
+			"mac_address":         sentence.Map["mac-address"],
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		// Helper function to set duration values
+		setDuration := func(metric *collector.GaugeVec, key string) {
+			value, err := parse.Duration(sentence.Map[key])
+			if err == nil {
+				metric.With(labels).Set(value)
+			}
+		}
+
+		setDuration(h.uptime, "uptime")
+		setDuration(h.sessionTimeLeft, "session-time-left")
+		setDuration(h.idleTime, "idle-time")
+
+		if bytesIn, err := strconv.ParseFloat(sentence.Map["bytes-in"], 64); err == nil {
+			h.bytesIn.With(labels).Set(bytesIn)
+		}
+		if bytesOut, err := strconv.ParseFloat(sentence.Map["bytes-out"], 64); err == nil {
+			h.bytesOut.With(labels).Set(bytesOut)
+		}
+
+		h.activeInfo.WithLabelValues(
+			sentence.Map["server"],
+			sentence.Map["user"],
+			sentence.Map["mac-address"],
+			sentence.Map["address"],
+			sentence.Map["login-by"],
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+	}
+
+	hostCount := make(map[[3]string]float64)
+	for _, sentence := range hostReply.Re {
+		hostCount[[3]string{sentence.Map["server"], sentence.Map["authorized"], sentence.Map["bypassed"]}]++
+	}
+	for key, count := range hostCount {
+		h.hosts.WithLabelValues(key[0], key[1], key[2], router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(count)
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (h *HotspotCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.Hotspot == nil {
+		return false
+	}
+	return *entry.Hotspot
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (h *HotspotCollector) Declare(registry prometheus.Registerer) error {
+	commonLabels := []string{"server", "user", "mac_address", "routerboard_address", "routerboard_name"}
+
+	h.activeInfo = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "hotspot_active_info",
+			Help:      "Information about hotspot active users.",
+		},
+		[]string{"server", "user", "mac_address", "address", "login_by", "routerboard_address", "routerboard_name"},
+	)
+	h.uptime = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "hotspot_active_uptime",
+			Help:      "Hotspot active user session uptime in seconds.",
+		},
+		commonLabels,
+	)
+	h.sessionTimeLeft = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "hotspot_active_session_time_left",
+			Help:      "Time left before the hotspot active user session expires (in seconds).",
+		},
+		commonLabels,
+	)
+	h.idleTime = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "hotspot_active_idle_time",
+			Help:      "Time since the hotspot active user was last active (in seconds).",
+		},
+		commonLabels,
+	)
+	h.bytesIn = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "hotspot_active_bytes_in",
+			Help:      "Number of bytes received from the hotspot active user.",
+		},
+		commonLabels,
+	)
+	h.bytesOut = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "hotspot_active_bytes_out",
+			Help:      "Number of bytes sent to the hotspot active user.",
+		},
+		commonLabels,
+	)
+	h.hosts = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "hotspot_hosts",
+			Help:      "Number of hotspot hosts per server.",
+		},
+		[]string{"server", "authorized", "bypassed", "routerboard_address", "routerboard_name"},
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{h.activeInfo, h.uptime, h.sessionTimeLeft, h.idleTime, h.bytesIn, h.bytesOut, h.hosts} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
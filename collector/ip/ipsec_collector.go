@@ -0,0 +1,201 @@
+package ip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&IPSecCollector{})
+}
+
+type IPSecCollector struct {
+	peerInfo        *collector.GaugeVec
+	peerUptime      *collector.GaugeVec
+	peerPh2Total    *collector.GaugeVec
+	peerRxBytes     *collector.GaugeVec
+	peerTxBytes     *collector.GaugeVec
+	peerRxPackets   *collector.GaugeVec
+	peerTxPackets   *collector.GaugeVec
+	peerInstalledSA *collector.GaugeVec
+}
+
+// Collect retrieves IPSec active peers and installed SAs and sets Prometheus metrics.
+func (c *IPSecCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	peersReply, err := router.Conn.RunContext(ctx, "/ip/ipsec/active-peers/print")
+	if err != nil {
+		return fmt.Errorf("failed to run /ip/ipsec/active-peers/print command: %w", err)
+	}
+
+	saReply, err := router.Conn.RunContext(ctx, "/ip/ipsec/installed-sa/print", "proplist=src-address,dst-address")
+	if err != nil {
+		return fmt.Errorf("failed to run /ip/ipsec/installed-sa/print command: %w", err)
+	}
+
+	// Count installed SAs per address, each SA is counted for both of its endpoints
+	saCount := make(map[string]float64)
+	for _, sentence := range saReply.Re {
+		saCount[stripPort(sentence.Map["src-address"])]++
+		saCount[stripPort(sentence.Map["dst-address"])]++
+	}
+
+	defer collector.DeleteStaleMetrics(router, c.peerInfo, c.peerUptime, c.peerPh2Total, c.peerRxBytes, c.peerTxBytes, c.peerRxPackets, c.peerTxPackets, c.peerInstalledSA)
+
+	for _, sentence := range peersReply.Re {
+		remoteAddress := stripPort(sentence.Map["remote-address"])
+		peer := sentence.Map["id"]
+		if peer == "" {
+			peer = remoteAddress
+		}
+
+		labels := prometheus.Labels{
+			"peer":                peer,
+			"local_address":       stripPort(sentence.Map["local-address"]),
+			"remote_address":      remoteAddress,
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		// Helper function to set metric values
+		setMetricValue := func(metric *collector.GaugeVec, key string, sentenceMap map[string]string) {
+			value, err := strconv.ParseFloat(sentenceMap[key], 64)
+			if err == nil {
+				metric.With(labels).Set(value)
+			}
+		}
+
+		setMetricValue(c.peerPh2Total, "ph2-total", sentence.Map)
+		setMetricValue(c.peerRxBytes, "rx-bytes", sentence.Map)
+		setMetricValue(c.peerTxBytes, "tx-bytes", sentence.Map)
+		setMetricValue(c.peerRxPackets, "rx-packets", sentence.Map)
+		setMetricValue(c.peerTxPackets, "tx-packets", sentence.Map)
+
+		uptimeSeconds, err := parse.Duration(sentence.Map["uptime"])
+		if err == nil {
+			c.peerUptime.With(labels).Set(uptimeSeconds)
+		}
+
+		c.peerInstalledSA.With(labels).Set(saCount[remoteAddress])
+
+		c.peerInfo.WithLabelValues(
+			peer,
+			labels["local_address"],
+			remoteAddress,
+			sentence.Map["state"],
+			sentence.Map["side"],
+			sentence.Map["dynamic-address"],
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (c *IPSecCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.IPSec == nil {
+		return false
+	}
+	return *entry.IPSec
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (c *IPSecCollector) Declare(registry prometheus.Registerer) error {
+	commonLabels := []string{"peer", "local_address", "remote_address", "routerboard_address", "routerboard_name"}
+
+	c.peerInfo = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ipsec_peer_info",
+			Help:      "Information about IPSec active peers.",
+		},
+		[]string{"peer", "local_address", "remote_address", "state", "side", "dynamic_address", "routerboard_address", "routerboard_name"},
+	)
+	c.peerUptime = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ipsec_peer_uptime",
+			Help:      "IPSec active peer uptime in seconds.",
+		},
+		commonLabels,
+	)
+	c.peerPh2Total = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ipsec_peer_ph2_total",
+			Help:      "Number of phase 2 SAs negotiated with the IPSec peer.",
+		},
+		commonLabels,
+	)
+	c.peerRxBytes = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ipsec_peer_rx_byte",
+			Help:      "Number of bytes received from the IPSec peer.",
+		},
+		commonLabels,
+	)
+	c.peerTxBytes = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ipsec_peer_tx_byte",
+			Help:      "Number of bytes sent to the IPSec peer.",
+		},
+		commonLabels,
+	)
+	c.peerRxPackets = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ipsec_peer_rx_packet",
+			Help:      "Number of packets received from the IPSec peer.",
+		},
+		commonLabels,
+	)
+	c.peerTxPackets = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ipsec_peer_tx_packet",
+			Help:      "Number of packets sent to the IPSec peer.",
+		},
+		commonLabels,
+	)
+	c.peerInstalledSA = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ipsec_peer_installed_sa",
+			Help:      "Number of installed SAs with the IPSec peer as source or destination.",
+		},
+		commonLabels,
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{c.peerInfo, c.peerUptime, c.peerPh2Total, c.peerRxBytes, c.peerTxBytes, c.peerRxPackets, c.peerTxPackets, c.peerInstalledSA} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// stripPort removes an optional port suffix from a RouterOS address (e.g., "10.0.0.1[4500]" or "10.0.0.1:4500").
+func stripPort(address string) string {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	if strings.HasSuffix(address, "]") {
+		if idx := strings.LastIndex(address, "["); idx > 0 {
+			return address[:idx]
+		}
+	}
+	return address
+}
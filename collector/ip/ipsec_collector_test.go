@@ -0,0 +1,26 @@
+package ip
+
+import "testing"
+
+func TestStripPort(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "10.0.0.1[4500]", want: "10.0.0.1"},
+		{input: "10.0.0.1:4500", want: "10.0.0.1"},
+		{input: "10.0.0.1", want: "10.0.0.1"},
+		{input: "2001:db8::1[500]", want: "2001:db8::1"},
+		{input: "[2001:db8::1]:500", want: "2001:db8::1"},
+		{input: "2001:db8::1", want: "2001:db8::1"},
+		{input: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := stripPort(tt.input); got != tt.want {
+				t.Errorf("stripPort(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package ip
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&KidControlCollector{})
+}
+
+type KidControlCollector struct {
+	deviceInfo *collector.GaugeVec
+	rateUp     *collector.GaugeVec
+	rateDown   *collector.GaugeVec
+	bytesUp    *collector.GaugeVec
+	bytesDown  *collector.GaugeVec
+	idleTime   *collector.GaugeVec
+	blocked    *collector.GaugeVec
+	limited    *collector.GaugeVec
+}
+
+// Collect retrieves Kid Control devices and sets Prometheus metrics.
+func (k *KidControlCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	rply, err := router.Conn.RunContext(ctx, "/ip/kid-control/device/print")
+	if err != nil {
+		return fmt.Errorf("failed to run /ip/kid-control/device/print command: %w", err)
+	}
+
+	// Devices without an assigned user are only reported with kid_control_dynamic
+	includeDynamic := router.ConfigEntry.KidControlDynamic != nil && *router.ConfigEntry.KidControlDynamic
+
+	defer collector.DeleteStaleMetrics(router, k.deviceInfo, k.rateUp, k.rateDown, k.bytesUp, k.bytesDown, k.idleTime, k.blocked, k.limited)
+
+	for _, sentence := range rply.Re {
+		if sentence.Map["user"] == "" && !includeDynamic {
+			continue
+		}
+
+		labels := prometheus.Labels{
+			"name":                sentence.Map["name"],
+			"user":                sentence.Map["user"],
+			"mac_address":         sentence.Map["mac-address"],
+			"ip_address":          sentence.Map["ip-address"],
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		// Helper function to set metric values
+		setMetricValue := func(metric *collector.GaugeVec, key string, sentenceMap map[string]string) {
+			value, err := strconv.ParseFloat(sentenceMap[key], 64)
+			if err == nil {
+				metric.With(labels).Set(value)
+			}
+		}
+
+		setMetricValue(k.bytesUp, "bytes-up", sentence.Map)
+		setMetricValue(k.bytesDown, "bytes-down", sentence.Map)
+
+		if rate, err := parse.Rate(sentence.Map["rate-up"]); err == nil {
+			k.rateUp.With(labels).Set(rate)
+		}
+		if rate, err := parse.Rate(sentence.Map["rate-down"]); err == nil {
+			k.rateDown.With(labels).Set(rate)
+		}
+		if idle, err := parse.Duration(sentence.Map["idle-time"]); err == nil {
+			k.idleTime.With(labels).Set(idle)
+		}
+
+		if blocked, err := parse.Bool(sentence.Map["blocked"]); err == nil {
+			k.blocked.With(labels).Set(blocked)
+		}
+		if limited, err := parse.Bool(sentence.Map["limited"]); err == nil {
+			k.limited.With(labels).Set(limited)
+		}
+
+		k.deviceInfo.WithLabelValues(
+			sentence.Map["name"],
+			sentence.Map["user"],
+			sentence.Map["mac-address"],
+			sentence.Map["ip-address"],
+			sentence.Map["blocked"],
+			sentence.Map["limited"],
+			sentence.Map["inactive"],
+			sentence.Map["disabled"],
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (k *KidControlCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.KidControlAssigned != nil && *entry.KidControlAssigned {
+		return true
+	}
+	if entry.KidControlDynamic != nil && *entry.KidControlDynamic {
+		return true
+	}
+	return false
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (k *KidControlCollector) Declare(registry prometheus.Registerer) error {
+	commonLabels := []string{"name", "user", "mac_address", "ip_address", "routerboard_address", "routerboard_name"}
+
+	k.deviceInfo = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "kid_control_device_info",
+			Help:      "Information about Kid Control devices.",
+		},
+		[]string{"name", "user", "mac_address", "ip_address", "blocked", "limited", "inactive", "disabled", "routerboard_address", "routerboard_name"},
+	)
+	k.rateUp = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "kid_control_device_rate_up",
+			Help:      "Kid Control device upload rate (in bits per second).",
+		},
+		commonLabels,
+	)
+	k.rateDown = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "kid_control_device_rate_down",
+			Help:      "Kid Control device download rate (in bits per second).",
+		},
+		commonLabels,
+	)
+	k.bytesUp = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "kid_control_device_bytes_up",
+			Help:      "Number of bytes uploaded by the Kid Control device.",
+		},
+		commonLabels,
+	)
+	k.bytesDown = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "kid_control_device_bytes_down",
+			Help:      "Number of bytes downloaded by the Kid Control device.",
+		},
+		commonLabels,
+	)
+	k.idleTime = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "kid_control_device_idle_time",
+			Help:      "Time since the Kid Control device was last active (in seconds).",
+		},
+		commonLabels,
+	)
+	k.blocked = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "kid_control_device_blocked",
+			Help:      "Whether the Kid Control device is blocked (1) or not (0).",
+		},
+		commonLabels,
+	)
+	k.limited = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "kid_control_device_limited",
+			Help:      "Whether the Kid Control device is rate limited (1) or not (0).",
+		},
+		commonLabels,
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{k.deviceInfo, k.rateUp, k.rateDown, k.bytesUp, k.bytesDown, k.idleTime, k.blocked, k.limited} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+// Package parse converts the value formats returned by the RouterOS API into numbers usable as Prometheus metrics.
+package parse
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// durationUnits maps the RouterOS duration unit suffixes to seconds.
+var durationUnits = map[string]float64{
+	"w":  7 * 24 * 3600,
+	"d":  24 * 3600,
+	"h":  3600,
+	"m":  60,
+	"s":  1,
+	"ms": 1e-3,
+	"us": 1e-6,
+}
+
+// Duration converts a RouterOS duration (e.g., "1w2d3h4m5s", "5s120ms" or "2d03:04:05.250") to seconds.
+func Duration(duration string) (float64, error) {
+	if duration == "" {
+		return 0, fmt.Errorf("empty duration")
+	}
+
+	var total float64
+	rest := duration
+
+	// RouterOS v7 prints the hours part as a clock, optionally preceded by weeks and days
+	if idx := strings.LastIndexAny(rest, "wd"); strings.Contains(rest, ":") {
+		clock := rest[idx+1:]
+		rest = rest[:idx+1]
+		seconds, err := clockDuration(clock)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", duration, err)
+		}
+		total += seconds
+	}
+
+	for rest != "" {
+		end := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' })
+		if end <= 0 {
+			return 0, fmt.Errorf("invalid duration %q", duration)
+		}
+		value, err := strconv.ParseFloat(rest[:end], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q: %w", duration, err)
+		}
+		rest = rest[end:]
+
+		unitEnd := strings.IndexFunc(rest, func(r rune) bool { return r >= '0' && r <= '9' })
+		if unitEnd < 0 {
+			unitEnd = len(rest)
+		}
+		multiplier, ok := durationUnits[rest[:unitEnd]]
+		if !ok {
+			return 0, fmt.Errorf("invalid duration unit in %q", duration)
+		}
+		total += value * multiplier
+		rest = rest[unitEnd:]
+	}
+
+	return total, nil
+}
+
+// SignedDuration converts a RouterOS duration with an optional sign (e.g., "-1ms250us") to seconds.
+func SignedDuration(duration string) (float64, error) {
+	if rest, ok := strings.CutPrefix(duration, "-"); ok {
+		value, err := Duration(rest)
+		return -value, err
+	}
+	return Duration(strings.TrimPrefix(duration, "+"))
+}
+
+// clockDuration converts a "15:04:05" or "15:04:05.000" clock to seconds.
+func clockDuration(clock string) (float64, error) {
+	parts := strings.Split(clock, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("invalid clock %q", clock)
+	}
+
+	var total float64
+	for idx, multiplier := range []float64{3600, 60, 1} {
+		value, err := strconv.ParseFloat(parts[idx], 64)
+		if err != nil || value < 0 {
+			return 0, fmt.Errorf("invalid clock %q", clock)
+		}
+		total += value * multiplier
+	}
+	return total, nil
+}
+
+// rateUnits maps the RouterOS rate unit prefixes to their multiplier.
+var rateUnits = map[string]float64{
+	"":  1,
+	"k": 1e3,
+	"K": 1e3,
+	"M": 1e6,
+	"G": 1e9,
+	"T": 1e12,
+}
+
+// Rate converts a RouterOS rate (e.g., "12.5Mbps" or "0bps") to bits per second. Plain numbers are returned as is.
+func Rate(rate string) (float64, error) {
+	value := strings.TrimSuffix(rate, "bps")
+	unit := ""
+	if value != "" {
+		if _, ok := rateUnits[value[len(value)-1:]]; ok {
+			unit = value[len(value)-1:]
+			value = value[:len(value)-1]
+		}
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rate %q: %w", rate, err)
+	}
+	return parsed * rateUnits[unit], nil
+}
+
+// sizeUnits lists the RouterOS size suffixes with their multiplier, longest suffix first.
+var sizeUnits = []struct {
+	suffix     string
+	multiplier float64
+}{
+	{"KiB", 1 << 10},
+	{"MiB", 1 << 20},
+	{"GiB", 1 << 30},
+	{"TiB", 1 << 40},
+	{"B", 1},
+}
+
+// Size converts a RouterOS size (e.g., "1024KiB" or "12.0MiB") to bytes. Plain numbers are returned as is.
+func Size(size string) (float64, error) {
+	value := size
+	multiplier := 1.0
+	for _, unit := range sizeUnits {
+		if strings.HasSuffix(size, unit.suffix) {
+			value = strings.TrimSuffix(size, unit.suffix)
+			multiplier = unit.multiplier
+			break
+		}
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q: %w", size, err)
+	}
+	return parsed * multiplier, nil
+}
+
+// Bool converts a RouterOS boolean ("yes/no" or "true/false") to 1 or 0.
+func Bool(value string) (float64, error) {
+	switch value {
+	case "true", "yes":
+		return 1, nil
+	case "false", "no":
+		return 0, nil
+	}
+	return 0, fmt.Errorf("invalid boolean %q", value)
+}
+
+// Pair splits a RouterOS "a/b" value (e.g., simple queue "upload/download") into its two parts.
+func Pair(pair string) (string, string, error) {
+	first, second, ok := strings.Cut(pair, "/")
+	if !ok || strings.Contains(second, "/") {
+		return "", "", fmt.Errorf("invalid pair %q", pair)
+	}
+	return first, second, nil
+}
+
+// GMTOffset converts a RouterOS clock offset (e.g., "+02:00" or "-05:30") to seconds.
+func GMTOffset(offset string) (float64, error) {
+	if len(offset) < 2 || (offset[0] != '+' && offset[0] != '-') {
+		return 0, fmt.Errorf("invalid gmt offset %q", offset)
+	}
+	hours, minutes, _ := strings.Cut(offset[1:], ":")
+	h, err := strconv.Atoi(hours)
+	if err != nil {
+		return 0, fmt.Errorf("invalid gmt offset %q: %w", offset, err)
+	}
+	m := 0
+	if minutes != "" {
+		if m, err = strconv.Atoi(minutes); err != nil {
+			return 0, fmt.Errorf("invalid gmt offset %q: %w", offset, err)
+		}
+	}
+
+	seconds := float64(h*3600 + m*60)
+	if offset[0] == '-' {
+		seconds = -seconds
+	}
+	return seconds, nil
+}
+
+// timestampLayouts lists the date formats used by RouterOS v6 ("jan/02/2006 15:04:05") and v7.10+ ("2006-01-02 15:04:05").
+var timestampLayouts = []string{"Jan/02/2006 15:04:05", "2006-01-02 15:04:05", "Jan/02/2006", "2006-01-02"}
+
+// Timestamp converts a RouterOS date to a Unix timestamp, assuming the router clock is UTC.
+func Timestamp(timestamp string) (float64, error) {
+	for _, layout := range timestampLayouts {
+		parsed, err := time.Parse(layout, timestamp)
+		if err == nil {
+			return float64(parsed.Unix()), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid timestamp %q", timestamp)
+}
@@ -0,0 +1,260 @@
+package parse
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{input: "5s", want: 5},
+		{input: "4m5s", want: 245},
+		{input: "3h4m5s", want: 11045},
+		{input: "2d3h4m5s", want: 183845},
+		{input: "1w2d3h4m5s", want: 788645},
+		{input: "1w", want: 604800},
+		{input: "10d", want: 864000},
+		{input: "0s", want: 0},
+		{input: "120ms", want: 0.12},
+		{input: "5s120ms", want: 5.12},
+		{input: "1m5s300ms", want: 65.3},
+		{input: "250us", want: 0.00025},
+		{input: "1.5s", want: 1.5},
+		{input: "00:00:05", want: 5},
+		{input: "03:04:05", want: 11045},
+		{input: "2d03:04:05", want: 183845},
+		{input: "1w2d03:04:05", want: 788645},
+		{input: "00:00:01.250", want: 1.25},
+		{input: "", wantErr: true},
+		{input: "abc", wantErr: true},
+		{input: "5", wantErr: true},
+		{input: "5x", wantErr: true},
+		{input: "s5", wantErr: true},
+		{input: "1d2:3", wantErr: true},
+		{input: "1d aa:bb:cc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Duration(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Duration(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Duration(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignedDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{input: "1ms250us", want: 0.00125},
+		{input: "-1ms250us", want: -0.00125},
+		{input: "+5s", want: 5},
+		{input: "-2m", want: -120},
+		{input: "0s", want: 0},
+		{input: "", wantErr: true},
+		{input: "-", wantErr: true},
+		{input: "--5s", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := SignedDuration(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SignedDuration(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("SignedDuration(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRate(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{input: "0bps", want: 0},
+		{input: "512bps", want: 512},
+		{input: "100kbps", want: 100e3},
+		{input: "12.5Mbps", want: 12.5e6},
+		{input: "1Gbps", want: 1e9},
+		{input: "2Tbps", want: 2e12},
+		{input: "10M", want: 10e6},
+		{input: "1500000", want: 1500000},
+		{input: "", wantErr: true},
+		{input: "bps", wantErr: true},
+		{input: "fastbps", wantErr: true},
+		{input: "12.5Xbps", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Rate(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Rate(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("Rate(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSize(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{input: "1024KiB", want: 1048576},
+		{input: "12.0MiB", want: 12582912},
+		{input: "1.5GiB", want: 1610612736},
+		{input: "1TiB", want: 1099511627776},
+		{input: "512B", want: 512},
+		{input: "65536", want: 65536},
+		{input: "", wantErr: true},
+		{input: "KiB", wantErr: true},
+		{input: "12XB", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Size(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Size(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Size(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBool(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{input: "true", want: 1},
+		{input: "yes", want: 1},
+		{input: "false", want: 0},
+		{input: "no", want: 0},
+		{input: "", wantErr: true},
+		{input: "True", wantErr: true},
+		{input: "1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Bool(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Bool(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Bool(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPair(t *testing.T) {
+	tests := []struct {
+		input      string
+		wantFirst  string
+		wantSecond string
+		wantErr    bool
+	}{
+		{input: "0/0", wantFirst: "0", wantSecond: "0"},
+		{input: "1000000/2000000", wantFirst: "1000000", wantSecond: "2000000"},
+		{input: "12/", wantFirst: "12", wantSecond: ""},
+		{input: "", wantErr: true},
+		{input: "1000", wantErr: true},
+		{input: "1/2/3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			first, second, err := Pair(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Pair(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if first != tt.wantFirst || second != tt.wantSecond {
+				t.Errorf("Pair(%q) = %q, %q, want %q, %q", tt.input, first, second, tt.wantFirst, tt.wantSecond)
+			}
+		})
+	}
+}
+
+func TestTimestamp(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{input: "jan/02/2025 10:00:00", want: 1735812000},
+		{input: "Jan/02/2025 10:00:00", want: 1735812000},
+		{input: "2025-01-02 10:00:00", want: 1735812000},
+		{input: "2025-01-02", want: 1735776000},
+		{input: "jan/02/2025", want: 1735776000},
+		{input: "", wantErr: true},
+		{input: "never", wantErr: true},
+		{input: "2025-13-02 10:00:00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := Timestamp(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Timestamp(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("Timestamp(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGMTOffset(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    float64
+		wantErr bool
+	}{
+		{input: "+00:00", want: 0},
+		{input: "+02:00", want: 7200},
+		{input: "-05:30", want: -19800},
+		{input: "+05:45", want: 20700},
+		{input: "+3", want: 10800},
+		{input: "", wantErr: true},
+		{input: "02:00", wantErr: true},
+		{input: "+", wantErr: true},
+		{input: "+aa:00", wantErr: true},
+		{input: "+02:bb", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := GMTOffset(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GMTOffset(%q) error = %v, wantErr %v", tt.input, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("GMTOffset(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package ppp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// defaultMaxSessions is used when ppp_max_sessions is not configured.
+const defaultMaxSessions = 1000
+
+func init() {
+	collector.RegisterAvailableCollector(&PPPCollector{})
+}
+
+type PPPCollector struct {
+	activeSessions *collector.GaugeVec
+	secrets        *collector.GaugeVec
+	sessionInfo    *collector.GaugeVec
+	sessionUptime  *collector.GaugeVec
+	sessionRxBytes *collector.GaugeVec
+	sessionTxBytes *collector.GaugeVec
+}
+
+// Collect retrieves PPP active sessions and secrets and sets Prometheus metrics.
+func (p *PPPCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	activeReply, err := router.Conn.RunContext(ctx, "/ppp/active/print", "proplist=name,service,caller-id,address,uptime")
+	if err != nil {
+		return fmt.Errorf("failed to run /ppp/active/print command: %w", err)
+	}
+
+	secretReply, err := router.Conn.RunContext(ctx, "/ppp/secret/print", "proplist=name,service,profile,disabled")
+	if err != nil {
+		return fmt.Errorf("failed to run /ppp/secret/print command: %w", err)
+	}
+
+	defer collector.DeleteStaleMetrics(router, p.activeSessions, p.secrets, p.sessionInfo, p.sessionUptime, p.sessionRxBytes, p.sessionTxBytes)
+
+	// Secrets provide the profile of local users, RADIUS users have no secret
+	profiles := make(map[string]string)
+	secretCount := make(map[[3]string]float64)
+	for _, sentence := range secretReply.Re {
+		profiles[sentence.Map["name"]] = sentence.Map["profile"]
+		secretCount[[3]string{sentence.Map["service"], sentence.Map["profile"], sentence.Map["disabled"]}]++
+	}
+	for key, count := range secretCount {
+		p.secrets.WithLabelValues(key[0], key[1], key[2], router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(count)
+	}
+
+	sessionCount := make(map[[2]string]float64)
+	for _, sentence := range activeReply.Re {
+		sessionCount[[2]string{sentence.Map["service"], profiles[sentence.Map["name"]]}]++
+	}
+	for key, count := range sessionCount {
+		p.activeSessions.WithLabelValues(key[0], key[1], router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(count)
+	}
+
+	// Per-session series grow with the number of subscribers, only export them below the configured limit
+	maxSessions := defaultMaxSessions
+	if router.ConfigEntry.PPPMaxSessions > 0 {
+		maxSessions = router.ConfigEntry.PPPMaxSessions
+	}
+	if len(activeReply.Re) > maxSessions {
+		return nil
+	}
+
+	interfaceReply, err := router.Conn.RunContext(ctx, "/interface/print", "?dynamic=true", "proplist=name,rx-byte,tx-byte")
+	if err != nil {
+		return fmt.Errorf("failed to run /interface/print command: %w", err)
+	}
+
+	interfaces := make(map[string]map[string]string)
+	for _, sentence := range interfaceReply.Re {
+		interfaces[sentence.Map["name"]] = sentence.Map
+	}
+
+	for _, sentence := range activeReply.Re {
+		labels := prometheus.Labels{
+			"name":                sentence.Map["name"],
+			"service":             sentence.Map["service"],
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		if uptime, err := parse.Duration(sentence.Map["uptime"]); err == nil {
+			p.sessionUptime.With(labels).Set(uptime)
+		}
+
+		// Dynamic server interfaces are named after the service and user, e.g. "<pppoe-alice>"
+		if iface, ok := interfaces[fmt.Sprintf("<%s-%s>", sentence.Map["service"], sentence.Map["name"])]; ok {
+			if rx, err := strconv.ParseFloat(iface["rx-byte"], 64); err == nil {
+				p.sessionRxBytes.With(labels).Set(rx)
+			}
+			if tx, err := strconv.ParseFloat(iface["tx-byte"], 64); err == nil {
+				p.sessionTxBytes.With(labels).Set(tx)
+			}
+		}
+
+		p.sessionInfo.WithLabelValues(
+			sentence.Map["name"],
+			sentence.Map["service"],
+			profiles[sentence.Map["name"]],
+			sentence.Map["caller-id"],
+			sentence.Map["address"],
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (p *PPPCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.PPP == nil {
+		return false
+	}
+	return *entry.PPP
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (p *PPPCollector) Declare(registry prometheus.Registerer) error {
+	sessionLabels := []string{"name", "service", "routerboard_address", "routerboard_name"}
+
+	p.activeSessions = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ppp_active_sessions",
+			Help:      "Number of active PPP sessions per service and profile.",
+		},
+		[]string{"service", "profile", "routerboard_address", "routerboard_name"},
+	)
+	p.secrets = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ppp_secrets",
+			Help:      "Number of configured PPP secrets per service and profile.",
+		},
+		[]string{"service", "profile", "disabled", "routerboard_address", "routerboard_name"},
+	)
+	p.sessionInfo = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ppp_session_info",
+			Help:      "Information about active PPP sessions.",
+		},
+		[]string{"name", "service", "profile", "caller_id", "address", "routerboard_address", "routerboard_name"},
+	)
+	p.sessionUptime = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ppp_session_uptime",
+			Help:      "Active PPP session uptime in seconds.",
+		},
+		sessionLabels,
+	)
+	p.sessionRxBytes = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ppp_session_rx_bytes",
+			Help:      "Number of bytes received on the PPP session interface.",
+		},
+		sessionLabels,
+	)
+	p.sessionTxBytes = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ppp_session_tx_bytes",
+			Help:      "Number of bytes sent on the PPP session interface.",
+		},
+		sessionLabels,
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{p.activeSessions, p.secrets, p.sessionInfo, p.sessionUptime, p.sessionRxBytes, p.sessionTxBytes} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
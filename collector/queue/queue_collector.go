@@ -0,0 +1,134 @@
+package queue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&QueueCollector{})
+}
+
+// queueStats maps the queue fields to the exported metric name suffixes.
+var queueStats = []struct {
+	key  string
+	name string
+	help string
+}{
+	{"bytes", "bytes", "Number of bytes passed through the queue."},
+	{"packets", "packets", "Number of packets passed through the queue."},
+	{"dropped", "dropped", "Number of packets dropped by the queue."},
+	{"queued-packets", "queued_packets", "Number of packets currently queued."},
+	{"queued-bytes", "queued_bytes", "Number of bytes currently queued."},
+	{"rate", "rate", "Current rate of the queue (in bits per second)."},
+	{"max-limit", "max_limit", "Maximum rate allowed by the queue (in bits per second, 0 is unlimited)."},
+}
+
+// queueDirections names the two halves of a simple queue "upload/download" value.
+var queueDirections = [2]string{"upload", "download"}
+
+type QueueCollector struct {
+	simple []*collector.GaugeVec
+	tree   []*collector.GaugeVec
+}
+
+// Collect retrieves simple queue and queue tree statistics and sets Prometheus metrics.
+func (q *QueueCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	simpleReply, err := router.Conn.RunContext(ctx, "/queue/simple/print", "proplist=name,target,bytes,packets,dropped,queued-packets,queued-bytes,rate,max-limit,disabled")
+	if err != nil {
+		return fmt.Errorf("failed to run /queue/simple/print command: %w", err)
+	}
+
+	treeReply, err := router.Conn.RunContext(ctx, "/queue/tree/print", "proplist=name,parent,packet-mark,bytes,packets,dropped,queued-packets,queued-bytes,rate,max-limit,disabled")
+	if err != nil {
+		return fmt.Errorf("failed to run /queue/tree/print command: %w", err)
+	}
+
+	defer collector.DeleteStaleMetrics(router, q.simple...)
+	defer collector.DeleteStaleMetrics(router, q.tree...)
+
+	for _, sentence := range simpleReply.Re {
+		if sentence.Map["disabled"] == "true" {
+			continue
+		}
+
+		// Simple queue values are reported as "upload/download"
+		for idx, stat := range queueStats {
+			upload, download, err := parse.Pair(sentence.Map[stat.key])
+			if err != nil {
+				continue
+			}
+			for half, raw := range [2]string{upload, download} {
+				value, err := strconv.ParseFloat(raw, 64)
+				if err != nil {
+					continue
+				}
+				q.simple[idx].WithLabelValues(sentence.Map["name"], sentence.Map["target"], queueDirections[half], router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(value)
+			}
+		}
+	}
+
+	for _, sentence := range treeReply.Re {
+		if sentence.Map["disabled"] == "true" {
+			continue
+		}
+
+		for idx, stat := range queueStats {
+			value, err := strconv.ParseFloat(sentence.Map[stat.key], 64)
+			if err == nil {
+				q.tree[idx].WithLabelValues(sentence.Map["name"], sentence.Map["parent"], sentence.Map["packet-mark"], router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (q *QueueCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.Queue == nil {
+		return true
+	}
+	return *entry.Queue
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (q *QueueCollector) Declare(registry prometheus.Registerer) error {
+	q.simple = make([]*collector.GaugeVec, 0, len(queueStats))
+	q.tree = make([]*collector.GaugeVec, 0, len(queueStats))
+
+	for _, stat := range queueStats {
+		simple := collector.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "mktxp",
+				Name:      "queue_simple_" + stat.name,
+				Help:      "Simple queue: " + stat.help,
+			},
+			[]string{"name", "target", "direction", "routerboard_address", "routerboard_name"},
+		)
+		tree := collector.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "mktxp",
+				Name:      "queue_tree_" + stat.name,
+				Help:      "Queue tree: " + stat.help,
+			},
+			[]string{"name", "parent", "packet_mark", "routerboard_address", "routerboard_name"},
+		)
+
+		for _, metric := range []*collector.GaugeVec{simple, tree} {
+			if err := registry.Register(metric); err != nil {
+				return fmt.Errorf("failed to register metric: %w", err)
+			}
+		}
+		q.simple = append(q.simple, simple)
+		q.tree = append(q.tree, tree)
+	}
+
+	return nil
+}
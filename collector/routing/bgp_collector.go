@@ -0,0 +1,253 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&BGPCollector{})
+}
+
+// bgpSession is a BGP session normalized from the RouterOS v6 and v7 formats.
+type bgpSession struct {
+	name          string
+	remoteAddress string
+	remoteAS      string
+	remoteID      string
+	localAddress  string
+	state         string
+	established   bool
+	uptime        string
+	prefixCount   string
+	counters      map[*collector.GaugeVec]string
+}
+
+type BGPCollector struct {
+	info              *collector.GaugeVec
+	established       *collector.GaugeVec
+	uptime            *collector.GaugeVec
+	prefixReceived    *collector.GaugeVec
+	prefixAdvertised  *collector.GaugeVec
+	updatesSent       *collector.GaugeVec
+	updatesReceived   *collector.GaugeVec
+	withdrawnSent     *collector.GaugeVec
+	withdrawnReceived *collector.GaugeVec
+	messagesSent      *collector.GaugeVec
+	messagesReceived  *collector.GaugeVec
+}
+
+// Collect retrieves BGP sessions (v6 peers or v7 sessions) and sets Prometheus metrics.
+func (b *BGPCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	v7, err := router.IsRouterOSv7(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to detect RouterOS version: %w", err)
+	}
+
+	var sessions []bgpSession
+	if v7 {
+		sessions, err = b.sessionsV7(ctx, router)
+	} else {
+		sessions, err = b.sessionsV6(ctx, router)
+	}
+	if err != nil {
+		return err
+	}
+
+	defer collector.DeleteStaleMetrics(router, b.metrics()...)
+
+	for _, session := range sessions {
+		labels := prometheus.Labels{
+			"name":                session.name,
+			"remote_address":      session.remoteAddress,
+			"remote_as":           session.remoteAS,
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		// Helper function to set metric values
+		setMetricValue := func(metric *collector.GaugeVec, raw string) {
+			value, err := strconv.ParseFloat(raw, 64)
+			if err == nil {
+				metric.With(labels).Set(value)
+			}
+		}
+
+		setMetricValue(b.prefixReceived, session.prefixCount)
+		for metric, raw := range session.counters {
+			setMetricValue(metric, raw)
+		}
+
+		if session.established {
+			b.established.With(labels).Set(1)
+		} else {
+			b.established.With(labels).Set(0)
+		}
+
+		if uptimeSeconds, err := parse.Duration(session.uptime); err == nil {
+			b.uptime.With(labels).Set(uptimeSeconds)
+		}
+
+		// Count-only keeps sessions advertising a full table cheap, the routes themselves are never sent
+		if advertised, err := b.advertisedPrefixes(ctx, router, session.name); err != nil {
+			log.Printf("failed to count BGP advertisements of session %s on router %s: %v", session.name, router.ConfigEntry.Name, err)
+		} else {
+			b.prefixAdvertised.With(labels).Set(advertised)
+		}
+
+		b.info.WithLabelValues(
+			session.name,
+			session.remoteAddress,
+			session.remoteAS,
+			session.remoteID,
+			session.localAddress,
+			session.state,
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+	}
+
+	return nil
+}
+
+// advertisedPrefixes counts the prefixes advertised to a BGP peer, advertisements are only kept
+// for sessions that have them enabled.
+func (b *BGPCollector) advertisedPrefixes(ctx context.Context, router *collector.RouterEntry, peer string) (float64, error) {
+	rply, err := router.Conn.RunContext(ctx, "/routing/bgp/advertisements/print", "=count-only=", "?peer="+peer)
+	if err != nil {
+		return 0, fmt.Errorf("failed to run /routing/bgp/advertisements/print command: %w", err)
+	}
+	if rply.Done == nil {
+		return 0, fmt.Errorf("missing 'ret' field in /routing/bgp/advertisements/print response")
+	}
+	return strconv.ParseFloat(rply.Done.Map["ret"], 64)
+}
+
+// sessionsV6 reads BGP peers from RouterOS v6.
+func (b *BGPCollector) sessionsV6(ctx context.Context, router *collector.RouterEntry) ([]bgpSession, error) {
+	rply, err := router.Conn.RunContext(ctx, "/routing/bgp/peer/print", "?disabled=false")
+	if err != nil {
+		return nil, fmt.Errorf("failed to run /routing/bgp/peer/print command: %w", err)
+	}
+
+	sessions := make([]bgpSession, 0, len(rply.Re))
+	for _, sentence := range rply.Re {
+		sessions = append(sessions, bgpSession{
+			name:          sentence.Map["name"],
+			remoteAddress: sentence.Map["remote-address"],
+			remoteAS:      sentence.Map["remote-as"],
+			remoteID:      sentence.Map["remote-id"],
+			localAddress:  sentence.Map["local-address"],
+			state:         sentence.Map["state"],
+			established:   sentence.Map["state"] == "established",
+			uptime:        sentence.Map["uptime"],
+			prefixCount:   sentence.Map["prefix-count"],
+			counters: map[*collector.GaugeVec]string{
+				b.updatesSent:       sentence.Map["updates-sent"],
+				b.updatesReceived:   sentence.Map["updates-received"],
+				b.withdrawnSent:     sentence.Map["withdrawn-sent"],
+				b.withdrawnReceived: sentence.Map["withdrawn-received"],
+			},
+		})
+	}
+	return sessions, nil
+}
+
+// sessionsV7 reads BGP sessions from RouterOS v7, which reports message counters instead of updates/withdrawals.
+func (b *BGPCollector) sessionsV7(ctx context.Context, router *collector.RouterEntry) ([]bgpSession, error) {
+	rply, err := router.Conn.RunContext(ctx, "/routing/bgp/session/print")
+	if err != nil {
+		return nil, fmt.Errorf("failed to run /routing/bgp/session/print command: %w", err)
+	}
+
+	sessions := make([]bgpSession, 0, len(rply.Re))
+	for _, sentence := range rply.Re {
+		established := sentence.Map["established"] == "true"
+		state := "idle"
+		if established {
+			state = "established"
+		} else if sentence.Map["stopped"] == "true" {
+			state = "stopped"
+		}
+
+		sessions = append(sessions, bgpSession{
+			name:          sentence.Map["name"],
+			remoteAddress: sentence.Map["remote.address"],
+			remoteAS:      sentence.Map["remote.as"],
+			remoteID:      sentence.Map["remote.id"],
+			localAddress:  sentence.Map["local.address"],
+			state:         state,
+			established:   established,
+			uptime:        sentence.Map["uptime"],
+			prefixCount:   sentence.Map["prefix-count"],
+			counters: map[*collector.GaugeVec]string{
+				b.messagesSent:     sentence.Map["local.messages"],
+				b.messagesReceived: sentence.Map["remote.messages"],
+			},
+		})
+	}
+	return sessions, nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (b *BGPCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.BGP == nil {
+		return false
+	}
+	return *entry.BGP
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (b *BGPCollector) Declare(registry prometheus.Registerer) error {
+	commonLabels := []string{"name", "remote_address", "remote_as", "routerboard_address", "routerboard_name"}
+
+	b.info = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "bgp_session_info",
+			Help:      "Information about BGP sessions.",
+		},
+		[]string{"name", "remote_address", "remote_as", "remote_id", "local_address", "state", "routerboard_address", "routerboard_name"},
+	)
+
+	newGauge := func(name, help string) *collector.GaugeVec {
+		return collector.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "mktxp",
+				Name:      name,
+				Help:      help,
+			},
+			commonLabels,
+		)
+	}
+	b.established = newGauge("bgp_session_established", "Whether the BGP session is established (1) or not (0).")
+	b.uptime = newGauge("bgp_session_uptime", "BGP session uptime in seconds.")
+	b.prefixReceived = newGauge("bgp_session_prefix_count_received", "Number of prefixes received from the BGP peer.")
+	b.prefixAdvertised = newGauge("bgp_session_prefix_count_advertised", "Number of prefixes advertised to the BGP peer.")
+	b.updatesSent = newGauge("bgp_session_updates_sent", "Number of update messages sent to the BGP peer (RouterOS v6).")
+	b.updatesReceived = newGauge("bgp_session_updates_received", "Number of update messages received from the BGP peer (RouterOS v6).")
+	b.withdrawnSent = newGauge("bgp_session_withdrawn_sent", "Number of withdrawals sent to the BGP peer (RouterOS v6).")
+	b.withdrawnReceived = newGauge("bgp_session_withdrawn_received", "Number of withdrawals received from the BGP peer (RouterOS v6).")
+	b.messagesSent = newGauge("bgp_session_messages_sent", "Number of messages sent to the BGP peer (RouterOS v7).")
+	b.messagesReceived = newGauge("bgp_session_messages_received", "Number of messages received from the BGP peer (RouterOS v7).")
+
+	// Register all metrics
+	for _, metric := range b.metrics() {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (b *BGPCollector) metrics() []*collector.GaugeVec {
+	return []*collector.GaugeVec{b.info, b.established, b.uptime, b.prefixReceived, b.prefixAdvertised, b.updatesSent, b.updatesReceived, b.withdrawnSent, b.withdrawnReceived, b.messagesSent, b.messagesReceived}
+}
@@ -0,0 +1,187 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&OSPFCollector{})
+}
+
+// ospfNeighborStates maps the OSPF neighbor states (RFC 2328) to numeric values, Full being the highest.
+var ospfNeighborStates = map[string]float64{
+	"down":     1,
+	"attempt":  2,
+	"init":     3,
+	"2-way":    4,
+	"exstart":  5,
+	"exchange": 6,
+	"loading":  7,
+	"full":     8,
+}
+
+type OSPFCollector struct {
+	neighborInfo         *collector.GaugeVec
+	neighborState        *collector.GaugeVec
+	neighborAdjacency    *collector.GaugeVec
+	neighborStateChanges *collector.GaugeVec
+	interfaceCost        *collector.GaugeVec
+	interfaceInfo        *collector.GaugeVec
+}
+
+// Collect retrieves OSPF neighbors and interfaces and sets Prometheus metrics.
+func (o *OSPFCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	neighborReply, err := router.Conn.RunContext(ctx, "/routing/ospf/neighbor/print")
+	if err != nil {
+		return fmt.Errorf("failed to run /routing/ospf/neighbor/print command: %w", err)
+	}
+
+	interfaceReply, err := router.Conn.RunContext(ctx, "/routing/ospf/interface/print")
+	if err != nil {
+		return fmt.Errorf("failed to run /routing/ospf/interface/print command: %w", err)
+	}
+
+	defer collector.DeleteStaleMetrics(router, o.neighborInfo, o.neighborState, o.neighborAdjacency, o.neighborStateChanges, o.interfaceCost, o.interfaceInfo)
+
+	// RouterOS v6 and v7 share the neighbor menu, v6 has no area and names the designated routers differently
+	for _, sentence := range neighborReply.Re {
+		labels := prometheus.Labels{
+			"instance":            sentence.Map["instance"],
+			"area":                sentence.Map["area"],
+			"router_id":           sentence.Map["router-id"],
+			"address":             sentence.Map["address"],
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		if state, ok := ospfNeighborStates[strings.ToLower(sentence.Map["state"])]; ok {
+			o.neighborState.With(labels).Set(state)
+		}
+		if adjacency, err := parse.Duration(sentence.Map["adjacency"]); err == nil {
+			o.neighborAdjacency.With(labels).Set(adjacency)
+		}
+		if changes, err := strconv.ParseFloat(sentence.Map["state-changes"], 64); err == nil {
+			o.neighborStateChanges.With(labels).Set(changes)
+		}
+
+		dr := sentence.Map["dr"]
+		if dr == "" {
+			dr = sentence.Map["dr-address"]
+		}
+		bdr := sentence.Map["bdr"]
+		if bdr == "" {
+			bdr = sentence.Map["backup-dr-address"]
+		}
+		o.neighborInfo.WithLabelValues(
+			sentence.Map["instance"],
+			sentence.Map["area"],
+			sentence.Map["router-id"],
+			sentence.Map["address"],
+			sentence.Map["interface"],
+			sentence.Map["state"],
+			dr,
+			bdr,
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+	}
+
+	for _, sentence := range interfaceReply.Re {
+		if sentence.Map["disabled"] == "true" {
+			continue
+		}
+
+		if cost, err := strconv.ParseFloat(sentence.Map["cost"], 64); err == nil {
+			o.interfaceCost.WithLabelValues(sentence.Map["interface"], sentence.Map["area"], router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(cost)
+		}
+		o.interfaceInfo.WithLabelValues(
+			sentence.Map["interface"],
+			sentence.Map["area"],
+			sentence.Map["network-type"],
+			sentence.Map["state"],
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (o *OSPFCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.OSPF == nil {
+		return false
+	}
+	return *entry.OSPF
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (o *OSPFCollector) Declare(registry prometheus.Registerer) error {
+	neighborLabels := []string{"instance", "area", "router_id", "address", "routerboard_address", "routerboard_name"}
+
+	o.neighborInfo = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ospf_neighbor_info",
+			Help:      "Information about OSPF neighbors.",
+		},
+		[]string{"instance", "area", "router_id", "address", "interface", "state", "dr", "bdr", "routerboard_address", "routerboard_name"},
+	)
+	o.neighborState = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ospf_neighbor_state",
+			Help:      "OSPF neighbor state (1 Down, 2 Attempt, 3 Init, 4 2-Way, 5 ExStart, 6 Exchange, 7 Loading, 8 Full).",
+		},
+		neighborLabels,
+	)
+	o.neighborAdjacency = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ospf_neighbor_adjacency",
+			Help:      "OSPF neighbor adjacency uptime in seconds.",
+		},
+		neighborLabels,
+	)
+	o.neighborStateChanges = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ospf_neighbor_state_changes",
+			Help:      "Number of OSPF neighbor state changes.",
+		},
+		neighborLabels,
+	)
+	o.interfaceCost = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ospf_interface_cost",
+			Help:      "OSPF interface cost.",
+		},
+		[]string{"interface", "area", "routerboard_address", "routerboard_name"},
+	)
+	o.interfaceInfo = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ospf_interface_info",
+			Help:      "Information about OSPF interfaces.",
+		},
+		[]string{"interface", "area", "network_type", "state", "routerboard_address", "routerboard_name"},
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{o.neighborInfo, o.neighborState, o.neighborAdjacency, o.neighborStateChanges, o.interfaceCost, o.interfaceInfo} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
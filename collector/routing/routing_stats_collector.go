@@ -0,0 +1,130 @@
+package routing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&RoutingStatsCollector{})
+}
+
+type RoutingStatsCollector struct {
+	processTime   *collector.GaugeVec
+	kernelTime    *collector.GaugeVec
+	privateMemory *collector.GaugeVec
+	sharedMemory  *collector.GaugeVec
+	taskCount     *collector.GaugeVec
+	curBusy       *collector.GaugeVec
+	maxBusy       *collector.GaugeVec
+}
+
+// Collect retrieves routing process statistics and sets Prometheus metrics.
+func (r *RoutingStatsCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	v7, err := router.IsRouterOSv7(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to detect RouterOS version: %w", err)
+	}
+	// Routing process stats are only available on RouterOS v7
+	if !v7 {
+		return nil
+	}
+
+	rply, err := router.Conn.RunContext(ctx, "/routing/stats/process/print", "proplist=id,tasks,private-mem-blocks,shared-mem-blocks,process-time,kernel-time,cur-busy,max-busy")
+	if err != nil {
+		return fmt.Errorf("failed to run /routing/stats/process/print command: %w", err)
+	}
+
+	defer collector.DeleteStaleMetrics(router, r.processTime, r.kernelTime, r.privateMemory, r.sharedMemory, r.taskCount, r.curBusy, r.maxBusy)
+
+	for _, sentence := range rply.Re {
+		// Processes without tasks report an empty list, which must not count as one task
+		tasks := make([]string, 0)
+		for _, task := range strings.Split(sentence.Map["tasks"], ",") {
+			if task = strings.TrimSpace(task); task != "" {
+				tasks = append(tasks, task)
+			}
+		}
+		process := ""
+		if len(tasks) > 0 {
+			process = tasks[0]
+		}
+
+		labels := prometheus.Labels{
+			"id":                  sentence.Map["id"],
+			"process":             process,
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		setDuration := func(metric *collector.GaugeVec, key string) {
+			value, err := parse.Duration(sentence.Map[key])
+			if err == nil {
+				metric.With(labels).Set(value)
+			}
+		}
+		setSize := func(metric *collector.GaugeVec, key string) {
+			value, err := parse.Size(sentence.Map[key])
+			if err == nil {
+				metric.With(labels).Set(value)
+			}
+		}
+
+		setDuration(r.processTime, "process-time")
+		setDuration(r.kernelTime, "kernel-time")
+		setDuration(r.curBusy, "cur-busy")
+		setDuration(r.maxBusy, "max-busy")
+		setSize(r.privateMemory, "private-mem-blocks")
+		setSize(r.sharedMemory, "shared-mem-blocks")
+
+		r.taskCount.With(labels).Set(float64(len(tasks)))
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (r *RoutingStatsCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.RoutingStats == nil {
+		return false
+	}
+	return *entry.RoutingStats
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (r *RoutingStatsCollector) Declare(registry prometheus.Registerer) error {
+	commonLabels := []string{"id", "process", "routerboard_address", "routerboard_name"}
+
+	newGauge := func(name, help string) *collector.GaugeVec {
+		return collector.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "mktxp",
+				Name:      name,
+				Help:      help,
+			},
+			commonLabels,
+		)
+	}
+	r.processTime = newGauge("routing_process_time", "CPU time used by the routing process (in seconds).")
+	r.kernelTime = newGauge("routing_process_kernel_time", "Kernel CPU time used by the routing process (in seconds).")
+	r.privateMemory = newGauge("routing_process_private_memory", "Private memory used by the routing process (in bytes).")
+	r.sharedMemory = newGauge("routing_process_shared_memory", "Shared memory used by the routing process (in bytes).")
+	r.taskCount = newGauge("routing_process_tasks", "Number of tasks handled by the routing process.")
+	r.curBusy = newGauge("routing_process_cur_busy", "Current busy time of the routing process (in seconds).")
+	r.maxBusy = newGauge("routing_process_max_busy", "Maximum busy time of the routing process (in seconds).")
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{r.processTime, r.kernelTime, r.privateMemory, r.sharedMemory, r.taskCount, r.curBusy, r.maxBusy} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
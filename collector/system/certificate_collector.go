@@ -0,0 +1,98 @@
+package system
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&CertificateCollector{})
+}
+
+type CertificateCollector struct {
+	info         *collector.GaugeVec
+	invalidAfter *collector.GaugeVec
+}
+
+// Collect retrieves certificates and sets Prometheus metrics.
+func (c *CertificateCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	rply, err := router.Conn.RunContext(ctx, "/certificate/print", "proplist=name,common-name,issuer,fingerprint,trusted,authority,invalid-after")
+	if err != nil {
+		return fmt.Errorf("failed to run /certificate/print command: %w", err)
+	}
+
+	// invalid-after is in the router local time, the clock gives its offset to UTC
+	clockReply, err := router.Conn.RunContext(ctx, "/system/clock/print", "proplist=gmt-offset")
+	if err != nil {
+		return fmt.Errorf("failed to run /system/clock/print command: %w", err)
+	}
+	var routerOffset string
+	for _, sentence := range clockReply.Re {
+		routerOffset = sentence.Map["gmt-offset"]
+	}
+	gmtOffset, offsetErr := parse.GMTOffset(routerOffset)
+
+	defer collector.DeleteStaleMetrics(router, c.info, c.invalidAfter)
+
+	for _, sentence := range rply.Re {
+		c.info.WithLabelValues(
+			sentence.Map["name"],
+			sentence.Map["common-name"],
+			sentence.Map["issuer"],
+			sentence.Map["fingerprint"],
+			sentence.Map["trusted"],
+			sentence.Map["authority"],
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+
+		invalidAfter, err := parse.Timestamp(sentence.Map["invalid-after"])
+		if err == nil && offsetErr == nil {
+			c.invalidAfter.WithLabelValues(sentence.Map["name"], sentence.Map["common-name"], router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(invalidAfter - gmtOffset)
+		}
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (c *CertificateCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.Certificate == nil {
+		return false
+	}
+	return *entry.Certificate
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (c *CertificateCollector) Declare(registry prometheus.Registerer) error {
+	c.info = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "certificate_info",
+			Help:      "Information about certificates installed on the router.",
+		},
+		[]string{"name", "common_name", "issuer", "fingerprint", "trusted", "ca", "routerboard_address", "routerboard_name"},
+	)
+	c.invalidAfter = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "certificate_invalid_after",
+			Help:      "Certificate expiration time as a Unix timestamp.",
+		},
+		[]string{"name", "common_name", "routerboard_address", "routerboard_name"},
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{c.info, c.invalidAfter} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&DiskCollector{})
+}
+
+type DiskCollector struct {
+	info      *collector.GaugeVec
+	size      *collector.GaugeVec
+	free      *collector.GaugeVec
+	pathSize  *collector.GaugeVec
+	pathFiles *collector.GaugeVec
+}
+
+// Collect retrieves disks and the size of files under the configured paths and sets Prometheus metrics.
+func (d *DiskCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	if router.ConfigEntry.Disk != nil && *router.ConfigEntry.Disk {
+		if err := d.collectDisks(ctx, router); err != nil {
+			return err
+		}
+	}
+
+	if paths := parsePaths(router.ConfigEntry.FilePaths); len(paths) > 0 {
+		if err := d.collectFiles(ctx, router, paths); err != nil {
+			return err
+		}
+	}
+
+	// Only after every query succeeded, a failure keeps the last values
+	collector.DeleteStaleMetrics(router, d.info, d.size, d.free, d.pathSize, d.pathFiles)
+
+	return nil
+}
+
+// collectDisks reports every disk, RouterOS v6 names them where v7 uses slots.
+func (d *DiskCollector) collectDisks(ctx context.Context, router *collector.RouterEntry) error {
+	rply, err := router.Conn.RunContext(ctx, "/disk/print")
+	if err != nil {
+		return fmt.Errorf("failed to run /disk/print command: %w", err)
+	}
+
+	for _, sentence := range rply.Re {
+		slot := sentence.Map["slot"]
+		if slot == "" {
+			slot = sentence.Map["name"]
+		}
+		labels := prometheus.Labels{
+			"slot":                slot,
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		if size, err := parse.Size(sentence.Map["size"]); err == nil {
+			d.size.With(labels).Set(size)
+		}
+		if free, err := parse.Size(sentence.Map["free"]); err == nil {
+			d.free.With(labels).Set(free)
+		}
+
+		fs := sentence.Map["fs"]
+		if fs == "" {
+			fs = sentence.Map["file-system"]
+		}
+		state := "active"
+		if sentence.Map["disabled"] == "true" {
+			state = "disabled"
+		} else if sentence.Map["inactive"] == "true" {
+			state = "inactive"
+		}
+
+		d.info.WithLabelValues(slot, sentence.Map["type"], fs, sentence.Map["model"], state, router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(1)
+	}
+
+	return nil
+}
+
+// collectFiles sums the size of the files stored under each path.
+func (d *DiskCollector) collectFiles(ctx context.Context, router *collector.RouterEntry, paths []string) error {
+	rply, err := router.Conn.RunContext(ctx, "/file/print", "proplist=name,type,size")
+	if err != nil {
+		return fmt.Errorf("failed to run /file/print command: %w", err)
+	}
+
+	for _, path := range paths {
+		var total, files float64
+		prefix := path + "/"
+		for _, sentence := range rply.Re {
+			if sentence.Map["type"] == "directory" || !strings.HasPrefix(sentence.Map["name"], prefix) {
+				continue
+			}
+			if size, err := parse.Size(sentence.Map["size"]); err == nil {
+				total += size
+			}
+			files++
+		}
+
+		d.pathSize.WithLabelValues(path, router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(total)
+		d.pathFiles.WithLabelValues(path, router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(files)
+	}
+
+	return nil
+}
+
+// parsePaths splits a comma-separated file_paths setting, "None" disables it.
+func parsePaths(paths string) []string {
+	names := make([]string, 0)
+	for _, path := range strings.Split(paths, ",") {
+		path = strings.Trim(strings.TrimSpace(path), "/")
+		if path == "" || path == "None" {
+			continue
+		}
+		names = append(names, path)
+	}
+	return names
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (d *DiskCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.Disk != nil && *entry.Disk {
+		return true
+	}
+	return len(parsePaths(entry.FilePaths)) > 0
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (d *DiskCollector) Declare(registry prometheus.Registerer) error {
+	d.info = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "disk_info",
+			Help:      "Information about disks attached to the router.",
+		},
+		[]string{"slot", "type", "fs", "model", "state", "routerboard_address", "routerboard_name"},
+	)
+	d.size = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "disk_size",
+			Help:      "Total size of the disk (in bytes).",
+		},
+		[]string{"slot", "routerboard_address", "routerboard_name"},
+	)
+	d.free = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "disk_free",
+			Help:      "Free space on the disk (in bytes).",
+		},
+		[]string{"slot", "routerboard_address", "routerboard_name"},
+	)
+	d.pathSize = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "file_path_size",
+			Help:      "Total size of the files stored under the path (in bytes).",
+		},
+		[]string{"path", "routerboard_address", "routerboard_name"},
+	)
+	d.pathFiles = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "file_path_files",
+			Help:      "Number of files stored under the path.",
+		},
+		[]string{"path", "routerboard_address", "routerboard_name"},
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{d.info, d.size, d.free, d.pathSize, d.pathFiles} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
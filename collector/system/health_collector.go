@@ -0,0 +1,165 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&HealthCollector{})
+}
+
+type HealthCollector struct {
+	temperature      *collector.GaugeVec
+	voltage          *collector.GaugeVec
+	current          *collector.GaugeVec
+	fanSpeed         *collector.GaugeVec
+	powerConsumption *collector.GaugeVec
+	psuState         *collector.GaugeVec
+	psuInfo          *collector.GaugeVec
+}
+
+// Collect retrieves system health sensors and sets Prometheus metrics.
+func (h *HealthCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	rply, err := router.Conn.RunContext(ctx, "/system/health/print")
+	if err != nil {
+		return fmt.Errorf("failed to run /system/health/print command: %w", err)
+	}
+
+	// RouterOS v7 returns one name/value row per sensor, v6 a single row with a field per sensor
+	sensors := make(map[string]string)
+	for _, sentence := range rply.Re {
+		name, hasName := sentence.Map["name"]
+		value, hasValue := sentence.Map["value"]
+		if hasName && hasValue {
+			sensors[name] = value
+			continue
+		}
+		for key, value := range sentence.Map {
+			sensors[key] = value
+		}
+	}
+
+	defer collector.DeleteStaleMetrics(router, h.temperature, h.voltage, h.current, h.fanSpeed, h.powerConsumption, h.psuState, h.psuInfo)
+
+	for name, raw := range sensors {
+		if strings.HasPrefix(name, "psu") && strings.HasSuffix(name, "-state") {
+			psu := strings.TrimSuffix(name, "-state")
+			state := 0.0
+			if raw == "ok" {
+				state = 1
+			}
+			h.psuState.WithLabelValues(psu, router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(state)
+			h.psuInfo.WithLabelValues(psu, raw, router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(1)
+			continue
+		}
+
+		var metric *collector.GaugeVec
+		switch {
+		case strings.Contains(name, "temperature"):
+			metric = h.temperature
+		case strings.Contains(name, "voltage"):
+			metric = h.voltage
+		case strings.Contains(name, "current"):
+			metric = h.current
+		case strings.HasPrefix(name, "fan") && strings.HasSuffix(name, "-speed"):
+			metric = h.fanSpeed
+		case name == "power-consumption":
+			metric = h.powerConsumption
+		default:
+			continue
+		}
+
+		value, err := strconv.ParseFloat(raw, 64)
+		if err == nil {
+			metric.WithLabelValues(name, router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(value)
+		}
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (h *HealthCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.Health == nil {
+		return false
+	}
+	return *entry.Health
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (h *HealthCollector) Declare(registry prometheus.Registerer) error {
+	commonLabels := []string{"sensor", "routerboard_address", "routerboard_name"}
+
+	h.temperature = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "system_health_temperature",
+			Help:      "Temperature reported by the router health sensor (in Celsius).",
+		},
+		commonLabels,
+	)
+	h.voltage = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "system_health_voltage",
+			Help:      "Voltage reported by the router health sensor (in Volts).",
+		},
+		commonLabels,
+	)
+	h.current = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "system_health_current",
+			Help:      "Current reported by the router health sensor (in the unit reported by the router).",
+		},
+		commonLabels,
+	)
+	h.fanSpeed = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "system_health_fan_speed",
+			Help:      "Fan speed reported by the router health sensor (in RPM).",
+		},
+		commonLabels,
+	)
+	h.powerConsumption = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "system_health_power_consumption",
+			Help:      "Power consumption of the router (in Watts).",
+		},
+		commonLabels,
+	)
+	h.psuState = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "system_health_psu_ok",
+			Help:      "Whether the power supply unit state is ok (1) or not (0).",
+		},
+		[]string{"psu", "routerboard_address", "routerboard_name"},
+	)
+	h.psuInfo = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "system_health_psu_info",
+			Help:      "Information about the power supply unit state.",
+		},
+		[]string{"psu", "state", "routerboard_address", "routerboard_name"},
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{h.temperature, h.voltage, h.current, h.fanSpeed, h.powerConsumption, h.psuState, h.psuInfo} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
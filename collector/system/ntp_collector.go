@@ -0,0 +1,165 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&NTPCollector{})
+}
+
+type NTPCollector struct {
+	info         *collector.GaugeVec
+	synchronized *collector.GaugeVec
+	offset       *collector.GaugeVec
+	stratum      *collector.GaugeVec
+	clockSkew    *collector.GaugeVec
+}
+
+// Collect retrieves the NTP client status and the router clock and sets Prometheus metrics.
+func (n *NTPCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	ntpReply, err := router.Conn.RunContext(ctx, "/system/ntp/client/print")
+	if err != nil {
+		return fmt.Errorf("failed to run /system/ntp/client/print command: %w", err)
+	}
+
+	clockReply, err := router.Conn.RunContext(ctx, "/system/clock/print", "proplist=time,date,gmt-offset")
+	if err != nil {
+		return fmt.Errorf("failed to run /system/clock/print command: %w", err)
+	}
+	now := time.Now()
+
+	defer collector.DeleteStaleMetrics(router, n.info, n.synchronized, n.offset, n.stratum, n.clockSkew)
+
+	for _, sentence := range ntpReply.Re {
+		labels := prometheus.Labels{
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		// RouterOS v7 reports status, synced server, offset (in ms) and stratum;
+		// the v6 SNTP client only reports the last update source and adjustment
+		status := sentence.Map["status"]
+		server := sentence.Map["synced-server"]
+		if server == "" {
+			server = sentence.Map["last-update-from"]
+		}
+		if status == "" {
+			status = "not-synchronized"
+			if server != "" {
+				status = "synchronized"
+			}
+		}
+		if sentence.Map["enabled"] == "false" {
+			status = "disabled"
+		}
+
+		synchronized := 0.0
+		if status == "synchronized" {
+			synchronized = 1
+		}
+		n.synchronized.With(labels).Set(synchronized)
+
+		if offset, ok := sentence.Map["system-offset"]; ok {
+			offset = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(offset), "ms"))
+			if value, err := strconv.ParseFloat(offset, 64); err == nil {
+				n.offset.With(labels).Set(value / 1000)
+			}
+		} else if adjustment, err := parse.SignedDuration(sentence.Map["last-adjustment"]); err == nil {
+			n.offset.With(labels).Set(adjustment)
+		}
+
+		if stratum, err := strconv.ParseFloat(sentence.Map["synced-stratum"], 64); err == nil {
+			n.stratum.With(labels).Set(stratum)
+		}
+
+		n.info.WithLabelValues(status, server, sentence.Map["mode"], router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(1)
+	}
+
+	for _, sentence := range clockReply.Re {
+		routerTime, err := parse.Timestamp(sentence.Map["date"] + " " + sentence.Map["time"])
+		if err != nil {
+			continue
+		}
+		gmtOffset, err := parse.GMTOffset(sentence.Map["gmt-offset"])
+		if err != nil {
+			continue
+		}
+		// The router clock is local time, compare it in UTC with the exporter host clock
+		n.clockSkew.WithLabelValues(router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(routerTime - gmtOffset - float64(now.Unix()))
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (n *NTPCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.NTP == nil {
+		return false
+	}
+	return *entry.NTP
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (n *NTPCollector) Declare(registry prometheus.Registerer) error {
+	commonLabels := []string{"routerboard_address", "routerboard_name"}
+
+	n.info = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ntp_client_info",
+			Help:      "Information about the NTP client status.",
+		},
+		[]string{"status", "synced_server", "mode", "routerboard_address", "routerboard_name"},
+	)
+	n.synchronized = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ntp_client_synchronized",
+			Help:      "Whether the NTP client is synchronized (1) or not (0).",
+		},
+		commonLabels,
+	)
+	n.offset = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ntp_client_offset",
+			Help:      "Offset of the router clock to the NTP server (in seconds).",
+		},
+		commonLabels,
+	)
+	n.stratum = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "ntp_client_stratum",
+			Help:      "Stratum of the synchronized NTP server (RouterOS v7).",
+		},
+		commonLabels,
+	)
+	n.clockSkew = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "system_clock_skew",
+			Help:      "Difference between the router clock and the exporter host clock (in seconds, 1s resolution).",
+		},
+		commonLabels,
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{n.info, n.synchronized, n.offset, n.stratum, n.clockSkew} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package system
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&RouterboardCollector{})
+}
+
+type RouterboardCollector struct {
+	info           *collector.GaugeVec
+	upgradePending *collector.GaugeVec
+	licenseInfo    *collector.GaugeVec
+}
+
+// Collect retrieves routerboard hardware, firmware and license details and sets Prometheus metrics.
+func (r *RouterboardCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	rply, err := router.Conn.RunContext(ctx, "/system/routerboard/print")
+	if err != nil {
+		return fmt.Errorf("failed to run /system/routerboard/print command: %w", err)
+	}
+
+	licenseReply, err := router.Conn.RunContext(ctx, "/system/license/print")
+	if err != nil {
+		return fmt.Errorf("failed to run /system/license/print command: %w", err)
+	}
+
+	defer collector.DeleteStaleMetrics(router, r.info, r.upgradePending, r.licenseInfo)
+
+	for _, sentence := range rply.Re {
+		// Virtual machines (CHR, x86) report routerboard=false and no hardware details
+		if sentence.Map["routerboard"] == "false" {
+			continue
+		}
+
+		r.info.WithLabelValues(
+			sentence.Map["model"],
+			sentence.Map["serial-number"],
+			sentence.Map["firmware-type"],
+			sentence.Map["factory-firmware"],
+			sentence.Map["current-firmware"],
+			sentence.Map["upgrade-firmware"],
+			router.ConfigEntry.Hostname,
+			router.ConfigEntry.Name,
+		).Set(1)
+
+		pending := 0.0
+		if sentence.Map["upgrade-firmware"] != "" && sentence.Map["upgrade-firmware"] != sentence.Map["current-firmware"] {
+			pending = 1
+		}
+		r.upgradePending.WithLabelValues(router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(pending)
+	}
+
+	for _, sentence := range licenseReply.Re {
+		// RouterOS v6 reports the license level as nlevel, v7 as level
+		level := sentence.Map["level"]
+		if level == "" {
+			level = sentence.Map["nlevel"]
+		}
+		r.licenseInfo.WithLabelValues(sentence.Map["software-id"], level, router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(1)
+	}
+
+	return nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (r *RouterboardCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.Routerboard == nil {
+		return false
+	}
+	return *entry.Routerboard
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (r *RouterboardCollector) Declare(registry prometheus.Registerer) error {
+	r.info = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "system_routerboard_info",
+			Help:      "Information about the routerboard hardware and firmware.",
+		},
+		[]string{"model", "serial_number", "firmware_type", "factory_firmware", "current_firmware", "upgrade_firmware", "routerboard_address", "routerboard_name"},
+	)
+	r.upgradePending = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "system_routerboard_firmware_upgrade_pending",
+			Help:      "Whether the upgrade firmware differs from the current routerboard firmware (1) or not (0).",
+		},
+		[]string{"routerboard_address", "routerboard_name"},
+	)
+	r.licenseInfo = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "system_license_info",
+			Help:      "Information about the RouterOS license.",
+		},
+		[]string{"software_id", "level", "routerboard_address", "routerboard_name"},
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{r.info, r.upgradePending, r.licenseInfo} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
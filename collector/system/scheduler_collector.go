@@ -0,0 +1,154 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func init() {
+	collector.RegisterAvailableCollector(&SchedulerCollector{})
+}
+
+type SchedulerCollector struct {
+	runCount       *collector.GaugeVec
+	nextRun        *collector.GaugeVec
+	interval       *collector.GaugeVec
+	disabled       *collector.GaugeVec
+	scriptRunCount *collector.GaugeVec
+}
+
+// Collect retrieves scheduler entries and scripts and sets Prometheus metrics.
+func (s *SchedulerCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	schedulerReply, err := router.Conn.RunContext(ctx, "/system/scheduler/print", "proplist=name,run-count,next-run,interval,disabled")
+	if err != nil {
+		return fmt.Errorf("failed to run /system/scheduler/print command: %w", err)
+	}
+
+	scriptReply, err := router.Conn.RunContext(ctx, "/system/script/print", "proplist=name,run-count")
+	if err != nil {
+		return fmt.Errorf("failed to run /system/script/print command: %w", err)
+	}
+
+	// next-run is in the router local time, the clock gives the current date and offset to UTC
+	clockReply, err := router.Conn.RunContext(ctx, "/system/clock/print", "proplist=date,gmt-offset")
+	if err != nil {
+		return fmt.Errorf("failed to run /system/clock/print command: %w", err)
+	}
+	var routerDate, routerOffset string
+	for _, sentence := range clockReply.Re {
+		routerDate = sentence.Map["date"]
+		routerOffset = sentence.Map["gmt-offset"]
+	}
+	gmtOffset, offsetErr := parse.GMTOffset(routerOffset)
+
+	defer collector.DeleteStaleMetrics(router, s.runCount, s.nextRun, s.interval, s.disabled, s.scriptRunCount)
+
+	for _, sentence := range schedulerReply.Re {
+		labels := prometheus.Labels{
+			"name":                sentence.Map["name"],
+			"routerboard_address": router.ConfigEntry.Hostname,
+			"routerboard_name":    router.ConfigEntry.Name,
+		}
+
+		if runCount, err := strconv.ParseFloat(sentence.Map["run-count"], 64); err == nil {
+			s.runCount.With(labels).Set(runCount)
+		}
+		if interval, err := parse.Duration(sentence.Map["interval"]); err == nil {
+			s.interval.With(labels).Set(interval)
+		}
+		if disabled, err := parse.Bool(sentence.Map["disabled"]); err == nil {
+			s.disabled.With(labels).Set(disabled)
+		}
+		// Disabled and one-shot entries that already ran have no next run
+		if nextRun, err := parseNextRun(sentence.Map["next-run"], routerDate); err == nil && offsetErr == nil {
+			s.nextRun.With(labels).Set(nextRun - gmtOffset)
+		}
+	}
+
+	for _, sentence := range scriptReply.Re {
+		if runCount, err := strconv.ParseFloat(sentence.Map["run-count"], 64); err == nil {
+			s.scriptRunCount.WithLabelValues(sentence.Map["name"], router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(runCount)
+		}
+	}
+
+	return nil
+}
+
+// parseNextRun converts the scheduler next-run to a Unix timestamp in the router local time,
+// RouterOS v7 omits the date for runs later today so the router date is used.
+func parseNextRun(nextRun, routerDate string) (float64, error) {
+	if strings.Count(nextRun, ":") == 2 && !strings.Contains(nextRun, " ") {
+		nextRun = routerDate + " " + nextRun
+	}
+	return parse.Timestamp(nextRun)
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (s *SchedulerCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.Scheduler == nil {
+		return false
+	}
+	return *entry.Scheduler
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (s *SchedulerCollector) Declare(registry prometheus.Registerer) error {
+	commonLabels := []string{"name", "routerboard_address", "routerboard_name"}
+
+	s.runCount = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "scheduler_run_count",
+			Help:      "Number of times the scheduler entry was run.",
+		},
+		commonLabels,
+	)
+	s.nextRun = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "scheduler_next_run",
+			Help:      "Next run of the scheduler entry as a Unix timestamp.",
+		},
+		commonLabels,
+	)
+	s.interval = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "scheduler_interval",
+			Help:      "Interval between runs of the scheduler entry (in seconds, 0 runs once).",
+		},
+		commonLabels,
+	)
+	s.disabled = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "scheduler_disabled",
+			Help:      "Whether the scheduler entry is disabled (1) or not (0).",
+		},
+		commonLabels,
+	)
+	s.scriptRunCount = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "script_run_count",
+			Help:      "Number of times the script was run.",
+		},
+		commonLabels,
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{s.runCount, s.nextRun, s.interval, s.disabled, s.scriptRunCount} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/collector/parse"
 	"github.com/bumbacea/go-mktxp/config"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -34,6 +35,8 @@ func (r *ResourcesCollector) Collect(ctx context.Context, router *collector.Rout
 	}
 
 	for _, sentence := range rply.Re {
+		router.SetRouterOSVersion(sentence.Map["version"])
+
 		// Extract relevant fields
 		labels := prometheus.Labels{
 			"architecture_name":   sentence.Map["architecture-name"],
@@ -61,8 +64,8 @@ func (r *ResourcesCollector) Collect(ctx context.Context, router *collector.Rout
 		setMetricValue(r.cpuCount, "cpu-count", sentence.Map)
 		setMetricValue(r.cpuFrequency, "cpu-frequency", sentence.Map)
 
-		// Parse uptime from string format to seconds (if needed support uptime later parsing)
-		uptimeSeconds, err := parseDurationToSeconds(sentence.Map["uptime"])
+		// Parse uptime from RouterOS duration format to seconds
+		uptimeSeconds, err := parse.Duration(sentence.Map["uptime"])
 		if err == nil {
 			r.uptime.With(labels).Set(uptimeSeconds)
 		}
@@ -154,9 +157,3 @@ func (r *ResourcesCollector) Declare(registry prometheus.Registerer) error {
 
 	return nil
 }
-
-// parseDurationToSeconds converts a RouterOS duration string (e.g., "1d2h3m4s") to seconds.
-func parseDurationToSeconds(duration string) (float64, error) {
-	// Add logic for parsing Mikrotik uptime format into seconds
-	return strconv.ParseFloat(duration, 64) // Simple placeholder
-}
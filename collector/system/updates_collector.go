@@ -0,0 +1,139 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/bumbacea/go-mktxp/config"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// defaultCheckForUpdatesInterval is used when check_for_updates_interval is not configured.
+const defaultCheckForUpdatesInterval = 24 * time.Hour
+
+func init() {
+	collector.RegisterAvailableCollector(&UpdatesCollector{
+		checks: make(map[string]updateCheck),
+	})
+}
+
+// updateCheck is the cached result of the last update check of a router.
+type updateCheck struct {
+	checkedAt        time.Time
+	channel          string
+	installedVersion string
+	latestVersion    string
+	status           string
+}
+
+type UpdatesCollector struct {
+	info            *collector.GaugeVec
+	updateAvailable *collector.GaugeVec
+
+	// Routers are collected concurrently, guard the cached checks
+	mu     sync.Mutex
+	checks map[string]updateCheck
+}
+
+// Collect checks for RouterOS updates when the check interval elapsed and sets Prometheus metrics from the cached result.
+func (u *UpdatesCollector) Collect(ctx context.Context, router *collector.RouterEntry) error {
+	interval := defaultCheckForUpdatesInterval
+	if router.ConfigEntry.CheckForUpdatesInterval > 0 {
+		interval = time.Duration(router.ConfigEntry.CheckForUpdatesInterval) * time.Second
+	}
+
+	u.mu.Lock()
+	check, ok := u.checks[router.ConfigEntry.Name]
+	u.mu.Unlock()
+
+	if !ok || time.Since(check.checkedAt) >= interval {
+		// A failed check is cached as well, so it is not retried on every collection
+		check.checkedAt = time.Now()
+		if result, err := checkForUpdates(ctx, router); err != nil {
+			log.Printf("failed to check for updates of router %s: %v", router.ConfigEntry.Name, err)
+		} else {
+			check = result
+		}
+
+		u.mu.Lock()
+		u.checks[router.ConfigEntry.Name] = check
+		u.mu.Unlock()
+	}
+
+	defer collector.DeleteStaleMetrics(router, u.info, u.updateAvailable)
+
+	// Nothing to export until a check succeeded
+	if check.status == "" {
+		return nil
+	}
+
+	u.info.WithLabelValues(check.channel, check.installedVersion, check.latestVersion, check.status, router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(1)
+
+	available := 0.0
+	if check.latestVersion != "" && check.latestVersion != check.installedVersion {
+		available = 1
+	}
+	u.updateAvailable.WithLabelValues(check.channel, router.ConfigEntry.Hostname, router.ConfigEntry.Name).Set(available)
+
+	return nil
+}
+
+// checkForUpdates asks the router to check for a newer RouterOS version. It runs without a deadline of its
+// own: canceling a command on a connection in async mode (log streaming) stops the whole connection.
+func checkForUpdates(ctx context.Context, router *collector.RouterEntry) (updateCheck, error) {
+	rply, err := router.Conn.RunContext(ctx, "/system/package/update/check-for-updates")
+	if err != nil {
+		return updateCheck{}, fmt.Errorf("failed to run /system/package/update/check-for-updates command: %w", err)
+	}
+
+	// The command streams its progress, the last sentence holds the final status
+	check := updateCheck{checkedAt: time.Now()}
+	for _, sentence := range rply.Re {
+		check.channel = sentence.Map["channel"]
+		check.installedVersion = sentence.Map["installed-version"]
+		check.latestVersion = sentence.Map["latest-version"]
+		check.status = sentence.Map["status"]
+	}
+	return check, nil
+}
+
+// IsEnabled determines if this collector is enabled for the current router.
+func (u *UpdatesCollector) IsEnabled(entry config.RouterConfig) bool {
+	if entry.CheckForUpdates == nil {
+		return false
+	}
+	return *entry.CheckForUpdates
+}
+
+// Declare initializes the Prometheus gauges and registers them.
+func (u *UpdatesCollector) Declare(registry prometheus.Registerer) error {
+	u.info = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "system_update_info",
+			Help:      "Information about installed and latest available RouterOS versions.",
+		},
+		[]string{"channel", "installed_version", "latest_version", "status", "routerboard_address", "routerboard_name"},
+	)
+	u.updateAvailable = collector.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "mktxp",
+			Name:      "system_update_available",
+			Help:      "Whether a newer RouterOS version is available (1) or not (0).",
+		},
+		[]string{"channel", "routerboard_address", "routerboard_name"},
+	)
+
+	// Register all metrics
+	for _, metric := range []*collector.GaugeVec{u.info, u.updateAvailable} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
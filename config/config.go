@@ -23,6 +23,7 @@ type RouterConfig struct {
 	InstalledPackages  *bool
 	DHCP               *bool `ini:"dhcp"`
 	DHCPLease          *bool `ini:"dhcp_lease"`
+	DHCPClient         *bool `ini:"dhcp_client"`
 	Connections        *bool
 	ConnectionStats    *bool
 	Interface          *bool
@@ -30,12 +31,15 @@ type RouterConfig struct {
 	Pool               *bool
 	Firewall           *bool
 	Neighbor           *bool
-	DNS                *bool `ini:"dns"`
+	AddressList        string `ini:"address_list"`
+	DNS                *bool  `ini:"dns"`
+	Hotspot            *bool  `ini:"hotspot"`
 	IPv6Route          *bool
 	IPv6Pool           *bool
 	IPv6Firewall       *bool
 	IPv6Neighbor       *bool
-	POE                *bool `ini:"poe"`
+	IPv6AddressList    string `ini:"ipv6_address_list"`
+	POE                *bool  `ini:"poe"`
 	Monitor            *bool
 	Netwatch           *bool
 	PublicIP           *bool `ini:"public_ip"`
@@ -46,22 +50,40 @@ type RouterConfig struct {
 	EoIP               *bool `ini:"eoip"`
 	GRE                *bool `ini:"gre"`
 	IPIP               *bool `ini:"ipip"`
+	WireGuard          *bool `ini:"wireguard"`
+	VRRP               *bool `ini:"vrrp"`
+	Bridge             *bool `ini:"bridge"`
 	LTE                *bool `ini:"lte"`
 	IPSec              *bool `ini:"ipsec"`
-	SwitchPort         *bool
-	KidControlAssigned *bool
-	KidControlDynamic  *bool
+	PPP                *bool `ini:"ppp"`
+	PPPMaxSessions     int   `ini:"ppp_max_sessions"`
+	SwitchPort         *bool `ini:"switch_port"`
+	KidControlAssigned *bool `ini:"kid_control_assigned"`
+	KidControlDynamic  *bool `ini:"kid_control_dynamic"`
 	User               *bool
 	Queue              *bool
 	BGP                *bool `ini:"bgp"`
-	RoutingStats       *bool
+	OSPF               *bool `ini:"ospf"`
+	RoutingStats       *bool `ini:"routing_stats"`
 	Certificate        *bool
+	Health             *bool  `ini:"health"`
+	Routerboard        *bool  `ini:"routerboard"`
+	Container          *bool  `ini:"container"`
+	Scheduler          *bool  `ini:"scheduler"`
+	NTP                *bool  `ini:"ntp"`
+	Disk               *bool  `ini:"disk"`
+	FilePaths          string `ini:"file_paths"`
 	RemoteDHCPEntry    string
 	RemoteCAPsMANEntry string
 
-	UseCommentsOverNames *bool
-	CheckForUpdates      *bool
-	Name                 string
+	// Log streaming settings
+	LogStream  *bool  `ini:"log_stream"`
+	LogForward string `ini:"log_forward"`
+
+	UseCommentsOverNames    *bool
+	CheckForUpdates         *bool `ini:"check_for_updates"`
+	CheckForUpdatesInterval int   `ini:"check_for_updates_interval"`
+	Name                    string
 }
 
 // ParseConfig parses the configuration file into a map of RouterConfigs.
@@ -158,6 +180,9 @@ func MergeDefaults(defaultConfig RouterConfig, instanceConfig RouterConfig) Rout
 	if instanceConfig.DHCPLease == nil {
 		instanceConfig.DHCPLease = defaultConfig.DHCPLease
 	}
+	if instanceConfig.DHCPClient == nil {
+		instanceConfig.DHCPClient = defaultConfig.DHCPClient
+	}
 	if instanceConfig.Connections == nil {
 		instanceConfig.Connections = defaultConfig.Connections
 	}
@@ -179,9 +204,15 @@ func MergeDefaults(defaultConfig RouterConfig, instanceConfig RouterConfig) Rout
 	if instanceConfig.Neighbor == nil {
 		instanceConfig.Neighbor = defaultConfig.Neighbor
 	}
+	if instanceConfig.AddressList == "" {
+		instanceConfig.AddressList = defaultConfig.AddressList
+	}
 	if instanceConfig.DNS == nil {
 		instanceConfig.DNS = defaultConfig.DNS
 	}
+	if instanceConfig.Hotspot == nil {
+		instanceConfig.Hotspot = defaultConfig.Hotspot
+	}
 	if instanceConfig.IPv6Route == nil {
 		instanceConfig.IPv6Route = defaultConfig.IPv6Route
 	}
@@ -194,6 +225,9 @@ func MergeDefaults(defaultConfig RouterConfig, instanceConfig RouterConfig) Rout
 	if instanceConfig.IPv6Neighbor == nil {
 		instanceConfig.IPv6Neighbor = defaultConfig.IPv6Neighbor
 	}
+	if instanceConfig.IPv6AddressList == "" {
+		instanceConfig.IPv6AddressList = defaultConfig.IPv6AddressList
+	}
 	if instanceConfig.POE == nil {
 		instanceConfig.POE = defaultConfig.POE
 	}
@@ -227,12 +261,27 @@ func MergeDefaults(defaultConfig RouterConfig, instanceConfig RouterConfig) Rout
 	if instanceConfig.IPIP == nil {
 		instanceConfig.IPIP = defaultConfig.IPIP
 	}
+	if instanceConfig.WireGuard == nil {
+		instanceConfig.WireGuard = defaultConfig.WireGuard
+	}
+	if instanceConfig.VRRP == nil {
+		instanceConfig.VRRP = defaultConfig.VRRP
+	}
+	if instanceConfig.Bridge == nil {
+		instanceConfig.Bridge = defaultConfig.Bridge
+	}
 	if instanceConfig.LTE == nil {
 		instanceConfig.LTE = defaultConfig.LTE
 	}
 	if instanceConfig.IPSec == nil {
 		instanceConfig.IPSec = defaultConfig.IPSec
 	}
+	if instanceConfig.PPP == nil {
+		instanceConfig.PPP = defaultConfig.PPP
+	}
+	if instanceConfig.PPPMaxSessions == 0 {
+		instanceConfig.PPPMaxSessions = defaultConfig.PPPMaxSessions
+	}
 	if instanceConfig.SwitchPort == nil {
 		instanceConfig.SwitchPort = defaultConfig.SwitchPort
 	}
@@ -251,12 +300,42 @@ func MergeDefaults(defaultConfig RouterConfig, instanceConfig RouterConfig) Rout
 	if instanceConfig.BGP == nil {
 		instanceConfig.BGP = defaultConfig.BGP
 	}
+	if instanceConfig.OSPF == nil {
+		instanceConfig.OSPF = defaultConfig.OSPF
+	}
 	if instanceConfig.RoutingStats == nil {
 		instanceConfig.RoutingStats = defaultConfig.RoutingStats
 	}
 	if instanceConfig.Certificate == nil {
 		instanceConfig.Certificate = defaultConfig.Certificate
 	}
+	if instanceConfig.Health == nil {
+		instanceConfig.Health = defaultConfig.Health
+	}
+	if instanceConfig.Routerboard == nil {
+		instanceConfig.Routerboard = defaultConfig.Routerboard
+	}
+	if instanceConfig.Container == nil {
+		instanceConfig.Container = defaultConfig.Container
+	}
+	if instanceConfig.Scheduler == nil {
+		instanceConfig.Scheduler = defaultConfig.Scheduler
+	}
+	if instanceConfig.LogStream == nil {
+		instanceConfig.LogStream = defaultConfig.LogStream
+	}
+	if instanceConfig.LogForward == "" {
+		instanceConfig.LogForward = defaultConfig.LogForward
+	}
+	if instanceConfig.NTP == nil {
+		instanceConfig.NTP = defaultConfig.NTP
+	}
+	if instanceConfig.Disk == nil {
+		instanceConfig.Disk = defaultConfig.Disk
+	}
+	if instanceConfig.FilePaths == "" {
+		instanceConfig.FilePaths = defaultConfig.FilePaths
+	}
 	if instanceConfig.RemoteDHCPEntry == "" {
 		instanceConfig.RemoteDHCPEntry = defaultConfig.RemoteDHCPEntry
 	}
@@ -269,6 +348,9 @@ func MergeDefaults(defaultConfig RouterConfig, instanceConfig RouterConfig) Rout
 	if instanceConfig.CheckForUpdates == nil {
 		instanceConfig.CheckForUpdates = defaultConfig.CheckForUpdates
 	}
+	if instanceConfig.CheckForUpdatesInterval == 0 {
+		instanceConfig.CheckForUpdatesInterval = defaultConfig.CheckForUpdatesInterval
+	}
 
 	return instanceConfig
 
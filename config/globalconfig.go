@@ -12,6 +12,8 @@ type MKTXPConfig struct {
 	DelayIncDiv              int
 	Bandwidth                bool
 	BandwidthTestInterval    int
+	BandwidthTestURL         string
+	BandwidthTestSize        int
 	MinimalCollectInterval   int
 	VerboseMode              bool
 	FetchRoutersInParallel   bool
@@ -37,6 +39,8 @@ func LoadConfig(filename string) (*MKTXPConfig, error) {
 	config.DelayIncDiv = section.Key("delay_inc_div").MustInt(5)
 	config.Bandwidth = section.Key("bandwidth").MustBool(false)
 	config.BandwidthTestInterval = section.Key("bandwidth_test_interval").MustInt(600)
+	config.BandwidthTestURL = section.Key("bandwidth_test_url").MustString("http://localhost:8080/")
+	config.BandwidthTestSize = section.Key("bandwidth_test_size").MustInt(10485760)
 	config.MinimalCollectInterval = section.Key("minimal_collect_interval").MustInt(5)
 	config.VerboseMode = section.Key("verbose_mode").MustBool(false)
 	config.FetchRoutersInParallel = section.Key("fetch_routers_in_parallel").MustBool(false)
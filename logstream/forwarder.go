@@ -0,0 +1,100 @@
+package logstream
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// forwardTimeout bounds the delivery of a batch of entries to an HTTP endpoint.
+const forwardTimeout = 5 * time.Second
+
+// forwarder delivers log entries as JSON lines to a destination.
+type forwarder interface {
+	Forward(ctx context.Context, entries []Entry) error
+	Close() error
+}
+
+// newForwarder returns the forwarder for a log_forward destination, or nil when forwarding is disabled.
+func newForwarder(destination string) (forwarder, error) {
+	switch {
+	case destination == "" || destination == "None":
+		return nil, nil
+	case strings.HasPrefix(destination, "http://"), strings.HasPrefix(destination, "https://"):
+		return &httpForwarder{url: destination, client: &http.Client{Timeout: forwardTimeout}}, nil
+	default:
+		file, err := os.OpenFile(destination, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		return &fileForwarder{file: file}, nil
+	}
+}
+
+// fileForwarder appends entries to a local file.
+type fileForwarder struct {
+	file *os.File
+}
+
+func (f *fileForwarder) Forward(_ context.Context, entries []Entry) error {
+	lines, err := encodeLines(entries)
+	if err != nil {
+		return err
+	}
+	_, err = f.file.Write(lines)
+	return err
+}
+
+func (f *fileForwarder) Close() error {
+	return f.file.Close()
+}
+
+// httpForwarder posts batches of entries to an HTTP endpoint.
+type httpForwarder struct {
+	url    string
+	client *http.Client
+}
+
+func (h *httpForwarder) Forward(ctx context.Context, entries []Entry) error {
+	lines, err := encodeLines(entries)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.url, bytes.NewReader(lines))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (h *httpForwarder) Close() error {
+	return nil
+}
+
+// encodeLines encodes entries as newline delimited JSON.
+func encodeLines(entries []Entry) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for _, entry := range entries {
+		if err := encoder.Encode(entry); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
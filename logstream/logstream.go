@@ -0,0 +1,172 @@
+package logstream
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/bumbacea/go-mktxp/collector"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// severities lists the RouterOS log topics that describe the severity of an entry rather than its subsystem.
+var severities = map[string]bool{
+	"debug":    true,
+	"info":     true,
+	"warning":  true,
+	"error":    true,
+	"critical": true,
+}
+
+// listenQueueSize buffers the log sentences, so a slow consumer does not block the connection shared with the collectors.
+const listenQueueSize = 100
+
+// forwardQueueSize bounds the entries waiting to be forwarded, further entries are dropped.
+const forwardQueueSize = 1000
+
+// forwardBatchSize bounds the entries delivered to the log destination at once.
+const forwardBatchSize = 100
+
+// Entry is a single RouterOS log entry as forwarded to the log destination.
+type Entry struct {
+	Router  string `json:"router"`
+	Address string `json:"address"`
+	Time    string `json:"time"`
+	Topics  string `json:"topics"`
+	Message string `json:"message"`
+}
+
+// Streamer follows the log of routers and counts the entries per topic and severity.
+type Streamer struct {
+	entries *prometheus.CounterVec
+	dropped *prometheus.CounterVec
+}
+
+// Declare initializes the Prometheus counters and registers them.
+func (s *Streamer) Declare(registry prometheus.Registerer) error {
+	s.entries = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mktxp",
+			Name:      "log_entries_total",
+			Help:      "Number of RouterOS log entries per topic and severity.",
+		},
+		[]string{"topic", "severity", "routerboard_address", "routerboard_name"},
+	)
+
+	s.dropped = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "mktxp",
+			Name:      "log_entries_dropped_total",
+			Help:      "Number of RouterOS log entries dropped because the log destination could not keep up.",
+		},
+		[]string{"routerboard_address", "routerboard_name"},
+	)
+
+	// Register all metrics
+	for _, metric := range []*prometheus.CounterVec{s.entries, s.dropped} {
+		if err := registry.Register(metric); err != nil {
+			return fmt.Errorf("failed to register metric: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Follow streams the router log until the context is canceled, counting entries and forwarding them
+// to the router log_forward destination when one is configured.
+func (s *Streamer) Follow(ctx context.Context, router *collector.RouterEntry) error {
+	forwarder, err := newForwarder(router.ConfigEntry.LogForward)
+	if err != nil {
+		return fmt.Errorf("failed to create log forwarder: %w", err)
+	}
+
+	// Entries are forwarded from a separate goroutine, a slow destination must not stall the log stream
+	var queue chan Entry
+	var done chan struct{}
+	if forwarder != nil {
+		queue = make(chan Entry, forwardQueueSize)
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			defer forwarder.Close()
+			forwardAll(ctx, router, forwarder, queue)
+		}()
+		defer func() {
+			close(queue)
+			<-done
+		}()
+	}
+
+	// follow-only skips the entries already in the log, so restarts do not count them again
+	reply, err := router.Conn.ListenArgsQueueContext(ctx, []string{"/log/print", "=follow-only="}, listenQueueSize)
+	if err != nil {
+		return fmt.Errorf("failed to run /log/print command: %w", err)
+	}
+
+	for sentence := range reply.Chan() {
+		entry := Entry{
+			Router:  router.ConfigEntry.Name,
+			Address: router.ConfigEntry.Hostname,
+			Time:    sentence.Map["time"],
+			Topics:  sentence.Map["topics"],
+			Message: sentence.Map["message"],
+		}
+
+		topic, severity := splitTopics(entry.Topics)
+		s.entries.WithLabelValues(topic, severity, router.ConfigEntry.Hostname, router.ConfigEntry.Name).Inc()
+
+		if queue != nil {
+			select {
+			case queue <- entry:
+			default:
+				s.dropped.WithLabelValues(router.ConfigEntry.Hostname, router.ConfigEntry.Name).Inc()
+			}
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil
+	}
+	return reply.Err()
+}
+
+// forwardAll delivers the queued entries in batches until the queue is closed.
+func forwardAll(ctx context.Context, router *collector.RouterEntry, forwarder forwarder, queue <-chan Entry) {
+	batch := make([]Entry, 0, forwardBatchSize)
+	for entry := range queue {
+		// Take whatever else is already waiting, up to the batch size
+		batch = append(batch[:0], entry)
+	drain:
+		for len(batch) < forwardBatchSize {
+			select {
+			case entry, ok := <-queue:
+				if !ok {
+					break drain
+				}
+				batch = append(batch, entry)
+			default:
+				break drain
+			}
+		}
+
+		// A failing destination must not stop the counting, drop the batch instead
+		if err := forwarder.Forward(ctx, batch); err != nil {
+			log.Printf("failed to forward %d log entries of router %s: %v", len(batch), router.ConfigEntry.Name, err)
+		}
+	}
+}
+
+// splitTopics separates the subsystem topics (e.g., "system,dhcp") from the severity of a "topics" value.
+func splitTopics(topics string) (string, string) {
+	subsystems := make([]string, 0, 2)
+	severity := "info"
+	for _, topic := range strings.Split(topics, ",") {
+		if severities[topic] {
+			severity = topic
+			continue
+		}
+		subsystems = append(subsystems, topic)
+	}
+	return strings.Join(subsystems, ","), severity
+}
@@ -0,0 +1,28 @@
+package logstream
+
+import "testing"
+
+func TestSplitTopics(t *testing.T) {
+	tests := []struct {
+		input        string
+		wantTopic    string
+		wantSeverity string
+	}{
+		{input: "system,info", wantTopic: "system", wantSeverity: "info"},
+		{input: "system,error,critical", wantTopic: "system", wantSeverity: "critical"},
+		{input: "dhcp,warning", wantTopic: "dhcp", wantSeverity: "warning"},
+		{input: "firewall,debug,packet", wantTopic: "firewall,packet", wantSeverity: "debug"},
+		{input: "interface", wantTopic: "interface", wantSeverity: "info"},
+		{input: "error", wantTopic: "", wantSeverity: "error"},
+		{input: "", wantTopic: "", wantSeverity: "info"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			topic, severity := splitTopics(tt.input)
+			if topic != tt.wantTopic || severity != tt.wantSeverity {
+				t.Errorf("splitTopics(%q) = (%q, %q), want (%q, %q)", tt.input, topic, severity, tt.wantTopic, tt.wantSeverity)
+			}
+		})
+	}
+}
@@ -13,9 +13,17 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/bumbacea/go-mktxp/bandwidth"
 	"github.com/bumbacea/go-mktxp/collector"
+	_ "github.com/bumbacea/go-mktxp/collector/container"
+	_ "github.com/bumbacea/go-mktxp/collector/interfaces"
+	_ "github.com/bumbacea/go-mktxp/collector/ip"
+	_ "github.com/bumbacea/go-mktxp/collector/ppp"
+	_ "github.com/bumbacea/go-mktxp/collector/queue"
+	_ "github.com/bumbacea/go-mktxp/collector/routing"
 	_ "github.com/bumbacea/go-mktxp/collector/system"
 	"github.com/bumbacea/go-mktxp/config"
+	"github.com/bumbacea/go-mktxp/logstream"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
@@ -66,6 +74,25 @@ func main() {
 				return fmt.Errorf("failed to declare collector: %w", err)
 			}
 
+			streamer := &logstream.Streamer{}
+			if err := streamer.Declare(registry); err != nil {
+				return fmt.Errorf("failed to declare log streamer: %w", err)
+			}
+
+			// Start the bandwidth tester on its own schedule
+			if globalConfig.Bandwidth {
+				tester := bandwidth.NewTester(globalConfig)
+				if err := tester.Declare(registry); err != nil {
+					return fmt.Errorf("failed to declare bandwidth tester: %w", err)
+				}
+				log.Printf("Starting bandwidth tester against %s", globalConfig.BandwidthTestURL)
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					tester.Run(ctx)
+				}()
+			}
+
 			// Start collectors
 			for instanceName, instanceConfig := range instances {
 				mergedConfig := instanceConfig
@@ -80,7 +107,7 @@ func main() {
 				mergedConfig.Name = instanceName
 				log.Printf("Starting collector for router: %s", instanceName)
 
-				if err := startCollector(mergedConfig, ctx, wg); err != nil {
+				if err := startCollector(mergedConfig, streamer, ctx, wg); err != nil {
 					return fmt.Errorf("failed to start collector for router %s: %w", instanceName, err)
 				}
 			}
@@ -108,16 +135,28 @@ func main() {
 	}
 }
 
-func startCollector(conf config.RouterConfig, ctx context.Context, wg *sync.WaitGroup) error {
+func startCollector(conf config.RouterConfig, streamer *logstream.Streamer, ctx context.Context, wg *sync.WaitGroup) error {
 
 	router, err := collector.NewRouterEntry(conf)
 	if err != nil {
 		return fmt.Errorf("failed to create router entry: %w", err)
 	}
 
-	if err := router.Collect(nil); err != nil {
+	if err := router.Collect(ctx); err != nil {
 		return fmt.Errorf("failed to collect initial metrics: %w", err)
 	}
+
+	// Following the log switches the connection to async mode, which the collectors share afterwards
+	if conf.LogStream != nil && *conf.LogStream {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			log.Printf("Following log for router: %s", conf.Name)
+			if err := streamer.Follow(ctx, router); err != nil {
+				log.Printf("failed to follow log for router %s: %v", conf.Name, err)
+			}
+		}()
+	}
 	wg.Add(1)
 	go func() {
 		ticker := time.NewTicker(30 * time.Second)